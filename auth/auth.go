@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// 令牌签发方，校验时要求一致
+	tokenIssuer = "user_srv"
+	// 服务间调用令牌的Subject前缀，后面是调用方的服务名
+	servicePrefix = "svc:"
+	// 服务间调用的令牌每次调用时签发，有效期很短
+	serviceTokenExpire = time.Minute
+	// 密钥过短时拒绝启动
+	minSecretLen = 32
+)
+
+var ErrInvalidToken = errors.New("登录已失效，请重新登录")
+
+var (
+	// secret 用户令牌的密钥，用户服务签发，其他服务校验
+	secret []byte
+	// serviceSecret 服务间调用令牌的密钥，只配置给需要互相调用的服务，用户服务没有这个密钥
+	serviceSecret []byte
+)
+
+type identityKey struct{}
+
+// Identity 请求方的身份，用户令牌带UserId，服务间调用带Service
+type Identity struct {
+	UserId  int64
+	Service string
+	Roles   []string
+}
+
+type claims struct {
+	Roles []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Init 设置用户令牌的密钥，密钥为空或过短时拒绝启动
+func Init(key string) error {
+	if len(key) < minSecretLen {
+		return errors.New("invalid AuthConfig.Secret")
+	}
+	secret = []byte(key)
+	return nil
+}
+
+// InitService 设置服务间调用令牌的密钥，需要在Init之后调用
+// 密钥不能和用户令牌的密钥相同，否则持有用户令牌密钥就能冒充内部服务
+func InitService(key string) error {
+	if len(key) < minSecretLen || key == string(secret) {
+		return errors.New("invalid AuthConfig.ServiceSecret")
+	}
+	serviceSecret = []byte(key)
+	return nil
+}
+
+// GenToken 给用户签发令牌，返回令牌和过期时间，角色变更后需要重新登录才生效
+func GenToken(userId int64, roles []string, expire time.Duration) (string, time.Time, error) {
+	return signToken(secret, strconv.FormatInt(userId, 10), roles, expire)
+}
+
+// genServiceToken 服务间调用的令牌
+func genServiceToken(service string) (string, error) {
+	if len(serviceSecret) == 0 {
+		return "", errors.New("service secret not set")
+	}
+	token, _, err := signToken(serviceSecret, servicePrefix+service, []string{RoleService}, serviceTokenExpire)
+	return token, err
+}
+
+func signToken(key []byte, subject string, roles []string, expire time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expireAt := now.Add(expire)
+	c := claims{
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expireAt),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(key)
+	return token, expireAt, err
+}
+
+// ParseToken 校验令牌并取出请求方的身份，服务间调用的令牌只接受服务密钥签名
+func ParseToken(token string) (*Identity, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (interface{}, error) {
+		if !strings.HasPrefix(c.Subject, servicePrefix) {
+			return secret, nil
+		}
+		if len(serviceSecret) == 0 {
+			return nil, ErrInvalidToken
+		}
+		return serviceSecret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	ident := &Identity{Roles: c.Roles}
+	if service, ok := strings.CutPrefix(c.Subject, servicePrefix); ok {
+		if service == "" || !ident.hasRole(RoleService) {
+			return nil, ErrInvalidToken
+		}
+		ident.Service = service
+		return ident, nil
+	}
+	// 用户令牌不能带服务角色
+	ident.UserId, err = strconv.ParseInt(c.Subject, 10, 64)
+	if err != nil || ident.UserId <= 0 || ident.hasRole(RoleService) {
+		return nil, ErrInvalidToken
+	}
+	return ident, nil
+}
+
+// FromContext 请求方的身份，没有携带令牌时返回false
+func FromContext(ctx context.Context) (*Identity, bool) {
+	ident, ok := ctx.Value(identityKey{}).(*Identity)
+	return ident, ok
+}
+
+// UserId 当前登录的用户，没有携带用户令牌时返回false
+func UserId(ctx context.Context) (int64, bool) {
+	ident, ok := FromContext(ctx)
+	if !ok || ident.UserId <= 0 {
+		return 0, false
+	}
+	return ident.UserId, true
+}
+
+// authenticate 从metadata中读取令牌，网关会把HTTP的Authorization请求头转为authorization
+// 没有携带令牌时返回nil，是否允许匿名访问由方法的规则决定；携带了无效令牌直接拒绝
+func authenticate(ctx context.Context) (*Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, ErrInvalidToken.Error())
+	}
+	ident, err := ParseToken(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return ident, nil
+}
+
+// check 校验令牌和方法的访问规则，通过后把身份放入context
+func check(ctx context.Context, policy Policy, method string) (context.Context, error) {
+	ident, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(ctx, policy, method, ident); err != nil {
+		return nil, err
+	}
+	if ident == nil {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, identityKey{}, ident), nil
+}
+
+// UnaryAuth 按服务的访问规则校验令牌和访问权限，并把请求方的身份放入context
+func UnaryAuth(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := check(ctx, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth 流式接口的令牌和访问权限校验
+func StreamAuth(policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := check(ss.Context(), policy, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientAuth 调用其他服务时携带本服务的令牌
+func UnaryClientAuth(service string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		token, err := genServiceToken(service)
+		if err != nil {
+			return err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientAuth 流式调用其他服务时携带本服务的令牌
+func StreamClientAuth(service string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		token, err := genServiceToken(service)
+		if err != nil {
+			return nil, err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+const (
+	testUserKey    = "user-key-0123456789abcdef01234567"
+	testServiceKey = "service-key-0123456789abcdef0123"
+)
+
+// setKeys 设置测试用的密钥，serviceKey为空时模拟用户服务
+func setKeys(t *testing.T, userKey, serviceKey string) {
+	oldSecret, oldService := secret, serviceSecret
+	t.Cleanup(func() {
+		secret, serviceSecret = oldSecret, oldService
+	})
+	secret, serviceSecret = nil, nil
+	if err := Init(userKey); err != nil {
+		t.Fatal(err)
+	}
+	if serviceKey == "" {
+		return
+	}
+	if err := InitService(serviceKey); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInitService(t *testing.T) {
+	setKeys(t, testUserKey, "")
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{"", true},
+		{"short", true},
+		{testUserKey, true},
+		{testServiceKey, false},
+	}
+	for _, tt := range tests {
+		if err := InitService(tt.key); (err != nil) != tt.wantErr {
+			t.Errorf("InitService(%q) err = %v, wantErr %v", tt.key, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseToken(t *testing.T) {
+	setKeys(t, testUserKey, testServiceKey)
+
+	userToken, _, err := GenToken(42, []string{RoleBuyer}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ident, err := ParseToken(userToken)
+	if err != nil || ident.UserId != 42 || ident.Service != "" {
+		t.Errorf("ParseToken(user) = %+v, %v, want user 42", ident, err)
+	}
+
+	serviceToken, err := genServiceToken("order_srv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ident, err = ParseToken(serviceToken)
+	if err != nil || ident.Service != "order_srv" || ident.UserId != 0 {
+		t.Errorf("ParseToken(service) = %+v, %v, want service order_srv", ident, err)
+	}
+
+	// 持有用户令牌密钥不能冒充内部服务
+	forged, _, err := signToken(secret, servicePrefix+"order_srv", []string{RoleService}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseToken(forged); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("ParseToken(service signed with user key) err = %v, want %v", err, ErrInvalidToken)
+	}
+
+	// 服务密钥也不能签发用户令牌
+	forged, _, err = signToken(serviceSecret, "42", []string{RoleAdmin}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseToken(forged); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("ParseToken(user signed with service key) err = %v, want %v", err, ErrInvalidToken)
+	}
+
+	if _, err := ParseToken(userToken[:len(userToken)-2]); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("ParseToken(truncated) err = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+// 用户服务没有服务密钥，不能签发也不接受服务间调用的令牌
+func TestServiceTokenWithoutServiceKey(t *testing.T) {
+	setKeys(t, testUserKey, testServiceKey)
+	serviceToken, err := genServiceToken("order_srv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setKeys(t, testUserKey, "")
+	if _, err := genServiceToken("user_srv"); err == nil {
+		t.Error("genServiceToken() without service key err = nil, want error")
+	}
+	if _, err := ParseToken(serviceToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("ParseToken(service) without service key err = %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 角色，管理员拥有所有用户角色
+const (
+	RoleBuyer        = "buyer"
+	RoleMerchant     = "merchant"
+	RoleRoomOperator = "room_operator"
+	RoleAdmin        = "admin"
+	RoleService      = "service" // 服务间调用，不能分配给用户
+)
+
+// UserRoles 可以分配给用户的角色
+var UserRoles = []string{RoleBuyer, RoleMerchant, RoleRoomOperator, RoleAdmin}
+
+// Rule 方法的访问规则
+type Rule struct {
+	Login    bool     // 需要登录
+	Roles    []string // 需要其中任一角色，隐含需要登录
+	Internal bool     // 只允许其他服务调用，网关转发的请求一律拒绝
+}
+
+// Policy 服务各方法的访问规则，key是方法的全名
+type Policy map[string]Rule
+
+var (
+	Public   = Rule{}               // 允许匿名访问
+	Login    = Rule{Login: true}    // 需要登录
+	Internal = Rule{Internal: true} // 只允许其他服务调用
+)
+
+// RequireRoles 需要登录并且有其中任一角色
+func RequireRoles(roles ...string) Rule {
+	return Rule{Login: true, Roles: roles}
+}
+
+// 健康检查由consul调用，不需要令牌
+var healthPolicy = Policy{
+	"/grpc.health.v1.Health/Check": Public,
+	"/grpc.health.v1.Health/Watch": Public,
+}
+
+func (ident *Identity) hasRole(role string) bool {
+	for _, r := range ident.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyRole 是否有其中任一角色，管理员视为拥有所有用户角色
+func (ident *Identity) HasAnyRole(roles ...string) bool {
+	if ident.UserId > 0 && ident.hasRole(RoleAdmin) {
+		return true
+	}
+	for _, role := range roles {
+		if ident.hasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize 按方法的规则校验请求方，未声明规则的方法一律拒绝
+// 不经过网关的服务间调用可以访问所有方法
+func authorize(ctx context.Context, policy Policy, method string, ident *Identity) error {
+	rule, ok := policy[method]
+	if !ok {
+		rule, ok = healthPolicy[method]
+	}
+	if !ok {
+		return status.Error(codes.PermissionDenied, "没有访问权限")
+	}
+	if ident != nil && ident.Service != "" && !viaGateway(ctx) {
+		return nil
+	}
+	if rule.Internal {
+		return status.Error(codes.PermissionDenied, "没有访问权限")
+	}
+	if !rule.Login && len(rule.Roles) == 0 {
+		return nil
+	}
+	if ident == nil || ident.UserId <= 0 {
+		return status.Error(codes.Unauthenticated, "请先登录")
+	}
+	if len(rule.Roles) > 0 && !ident.HasAnyRole(rule.Roles...) {
+		return status.Error(codes.PermissionDenied, "没有访问权限")
+	}
+	return nil
+}
+
+// viaGateway 是否是网关转发的请求，grpc-gateway转发时总会带上x-forwarded-host
+func viaGateway(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return len(md.Get("x-forwarded-host")) > 0
+}
@@ -0,0 +1,306 @@
+package goods
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"good_service/third_party/snowflake"
+	"money"
+	"net/url"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var ErrGoodsNotFound = errors.New("商品不存在")
+
+// InvalidGoodsError 商品参数校验失败
+type InvalidGoodsError struct {
+	Field  string
+	Reason string
+}
+
+func (e *InvalidGoodsError) Error() string {
+	return fmt.Sprintf("%s%s", e.Field, e.Reason)
+}
+
+// 列表字段序列化后的长度限制，与xx_goods表字段长度一致
+const (
+	maxHeadImgsLen = 1024
+	maxVideosLen   = 1024
+	maxDetailLen   = 2048
+)
+
+// CreateGoods 创建商品，goods_id由snowflake生成
+func CreateGoods(ctx context.Context, req *proto.GoodsReq, operator string) (*proto.GoodsDetail, error) {
+	data, err := buildGoods(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGoodsCatalog(ctx, data); err != nil {
+		return nil, err
+	}
+	if err := checkGoodsMedia(ctx, req); err != nil {
+		return nil, err
+	}
+	if data.ExtJson, err = goodsExtJson(ctx, data.CategoryId, req.GetAttrs()); err != nil {
+		return nil, err
+	}
+	data.GoodsId = snowflake.GenID()
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	if err := mysql.CreateGoods(ctx, data); err != nil {
+		return nil, err
+	}
+	cache.DelGoods(ctx, data.GoodsId)
+	resp := toGoodsDetail(data)
+	fillAttrTypes(ctx, resp)
+	return resp, nil
+}
+
+// UpdateGoods 更新商品的可编辑字段
+func UpdateGoods(ctx context.Context, req *proto.GoodsReq, operator string) (*proto.GoodsDetail, error) {
+	old, err := mysql.GetGoodsByGoodsId(ctx, req.GetGoodsId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := buildGoods(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGoodsCatalog(ctx, data); err != nil {
+		return nil, err
+	}
+	if err := checkGoodsMedia(ctx, req); err != nil {
+		return nil, err
+	}
+	// 不传扩展属性时保留原来的属性，仍按当前类目重新校验
+	attrs := req.GetAttrs()
+	if len(attrs) == 0 {
+		attrs = attrValues(old.ExtJson)
+	}
+	if data.ExtJson, err = goodsExtJson(ctx, data.CategoryId, attrs); err != nil {
+		return nil, err
+	}
+	data.BaseMode = old.BaseMode
+	data.GoodsId = old.GoodsId
+	data.UpdateBy = operator
+	priceChanged := old.Price != data.Price || old.MarketPrice != data.MarketPrice
+	err = mysql.UpdateGoods(ctx, data, priceChanged)
+	if errors.Is(err, mysql.ErrGoodsNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache.DelGoods(ctx, data.GoodsId)
+	resp := toGoodsDetail(data)
+	fillAttrTypes(ctx, resp)
+	return resp, nil
+}
+
+// DeleteGoods 软删除商品
+func DeleteGoods(ctx context.Context, goodsId int64, operator string) error {
+	err := mysql.DeleteGoods(ctx, goodsId, operator)
+	if errors.Is(err, mysql.ErrGoodsNotFound) {
+		return ErrGoodsNotFound
+	}
+	if err != nil {
+		return err
+	}
+	cache.DelGoods(ctx, goodsId)
+	return nil
+}
+
+// ListGoods 按类目、品牌、状态和标题筛选商品
+func ListGoods(ctx context.Context, req *proto.ListGoodsReq) (*proto.ListGoodsResp, error) {
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	if err := checkAttrFilter(req.GetAttrs()); err != nil {
+		return nil, err
+	}
+	filter := &mysql.GoodsFilter{
+		Attrs:     req.GetAttrs(),
+		BrandName: req.GetBrandName(),
+		Status:    req.Status,
+		Title:     req.GetTitle(),
+		Offset:    (pageNum - 1) * pageSize,
+		Limit:     pageSize,
+	}
+	if req.GetCategoryId() > 0 {
+		ids, err := categoryTreeIds(ctx, req.GetCategoryId())
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return &proto.ListGoodsResp{Data: []*proto.GoodsDetail{}}, nil
+		}
+		filter.CategoryIds = ids
+	}
+	list, total, err := mysql.ListGoods(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.GoodsDetail, 0, len(list))
+	for _, goods := range list {
+		data = append(data, toGoodsDetail(goods))
+	}
+	fillAttrTypes(ctx, data...)
+	return &proto.ListGoodsResp{Total: total, Data: data}, nil
+}
+
+// checkGoodsCatalog 校验商品的类目和品牌
+func checkGoodsCatalog(ctx context.Context, data *model.Goods) error {
+	if err := checkGoodsCategory(ctx, data.CategoryId); err != nil {
+		return err
+	}
+	return checkGoodsBrand(ctx, data.BrandName)
+}
+
+// buildGoods 校验请求参数并转换为商品数据
+func buildGoods(req *proto.GoodsReq) (*model.Goods, error) {
+	if utf8.RuneCountInString(req.GetTitle()) == 0 || utf8.RuneCountInString(req.GetTitle()) > 255 {
+		return nil, &InvalidGoodsError{"Title", "长度需在1-255之间"}
+	}
+	if utf8.RuneCountInString(req.GetBrandName()) > 255 {
+		return nil, &InvalidGoodsError{"BrandName", "长度不能超过255"}
+	}
+	if utf8.RuneCountInString(req.GetBrief()) > 255 {
+		return nil, &InvalidGoodsError{"Brief", "长度不能超过255"}
+	}
+	if len(req.GetCode()) > 64 {
+		return nil, &InvalidGoodsError{"Code", "长度不能超过64"}
+	}
+	if req.GetStatus() != 0 && req.GetStatus() != 1 {
+		return nil, &InvalidGoodsError{"Status", "只能为0或1"}
+	}
+	if req.GetPrice() <= 0 || req.GetMarketPrice() < 0 {
+		return nil, &InvalidGoodsError{"Price", "有误"}
+	}
+	if req.GetOnShelfAt() < 0 || req.GetOffShelfAt() < 0 {
+		return nil, &InvalidGoodsError{"ShelfAt", "有误"}
+	}
+	if req.GetOnShelfAt() > 0 && req.GetOffShelfAt() > 0 && req.GetOffShelfAt() <= req.GetOnShelfAt() {
+		return nil, &InvalidGoodsError{"OffShelfAt", "必须晚于上架时间"}
+	}
+
+	headImgs, err := encodeList("HeadImgs", req.GetHeadImgs(), true, maxHeadImgsLen)
+	if err != nil {
+		return nil, err
+	}
+	headImgIds, err := encodeMediaIds("HeadImgIds", req.GetHeadImgIds(), maxHeadImgIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetHeadImgs()) == 0 && len(req.GetHeadImgIds()) == 0 {
+		return nil, &InvalidGoodsError{"HeadImgs", "至少需要一张"}
+	}
+	videos, err := encodeList("Videos", req.GetVideos(), true, maxVideosLen)
+	if err != nil {
+		return nil, err
+	}
+	videoIds, err := encodeMediaIds("VideoIds", req.GetVideoIds(), maxVideoIds)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := encodeList("Detail", req.GetDetail(), false, maxDetailLen)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Goods{
+		CategoryId:  req.GetCategoryId(),
+		BrandName:   req.GetBrandName(),
+		Code:        req.GetCode(),
+		Status:      int8(req.GetStatus()),
+		Title:       req.GetTitle(),
+		MarketPrice: req.GetMarketPrice(),
+		Price:       req.GetPrice(),
+		Brief:       req.GetBrief(),
+		HeadImgs:    headImgs,
+		Videos:      videos,
+		HeadImgIds:  headImgIds,
+		VideoIds:    videoIds,
+		Detail:      detail,
+		OnShelfAt:   unixTime(req.GetOnShelfAt()),
+		OffShelfAt:  unixTime(req.GetOffShelfAt()),
+	}, nil
+}
+
+// encodeList 校验列表字段并序列化为json数组，isURL为true时每一项都必须是http(s)地址
+func encodeList(field string, list []string, isURL bool, maxLen int) (string, error) {
+	if len(list) == 0 {
+		return "", nil
+	}
+	for _, item := range list {
+		if len(item) == 0 {
+			return "", &InvalidGoodsError{field, "不能包含空值"}
+		}
+		if isURL {
+			u, err := url.Parse(item)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				return "", &InvalidGoodsError{field, "必须是http(s)地址"}
+			}
+		}
+	}
+	b, _ := json.Marshal(list)
+	if len(b) > maxLen {
+		return "", &InvalidGoodsError{field, fmt.Sprintf("总长度不能超过%d", maxLen)}
+	}
+	return string(b), nil
+}
+
+// decodeList 反序列化列表字段，非法数据返回空列表
+func decodeList(s string) []string {
+	var list []string
+	if len(s) > 0 {
+		if err := json.Unmarshal([]byte(s), &list); err != nil {
+			zap.L().Error("decodeList failed", zap.String("value", s), zap.Error(err))
+		}
+	}
+	return list
+}
+
+func toGoodsDetail(data *model.Goods) *proto.GoodsDetail {
+	headImgs, headThumbs := goodsHeadImgs(data)
+	return &proto.GoodsDetail{
+		GoodsId:         data.GoodsId,
+		CategoryId:      data.CategoryId,
+		Status:          int32(data.Status),
+		BrandName:       data.BrandName,
+		Code:            data.Code,
+		Title:           data.Title,
+		MarketPrice:     money.FromCent(data.MarketPrice).String(),
+		Price:           money.FromCent(data.Price).String(),
+		MarketPriceCent: data.MarketPrice,
+		PriceCent:       data.Price,
+		Brief:           data.Brief,
+		HeadImgs:        headImgs,
+		HeadThumbs:      headThumbs,
+		HeadImgIds:      decodeMediaIds(data.HeadImgIds),
+		Videos:          goodsVideos(data),
+		VideoIds:        decodeMediaIds(data.VideoIds),
+		Detail:          decodeList(data.Detail),
+		Attrs:           decodeAttrs(data.ExtJson),
+		OnShelfAt:       unixSecond(data.OnShelfAt),
+		OffShelfAt:      unixSecond(data.OffShelfAt),
+		Rating:          goodsRating(data),
+		RatingCount:     data.ReviewCount,
+		Sales:           data.Sales,
+	}
+}
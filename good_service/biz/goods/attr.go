@@ -0,0 +1,312 @@
+package goods
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 属性名作为ext_json的key，也用于拼接json path，只允许字母、数字和下划线
+var attrNameRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,63}$`)
+
+// ext_json字段长度限制，与xx_goods表字段长度一致
+const maxExtJsonLen = 2048
+
+// SetCategoryAttrs 整体设置类目自身的属性，属性名不能与上级或子类目的属性重复
+func SetCategoryAttrs(ctx context.Context, req *proto.SetCategoryAttrsReq, operator string) (*proto.CategoryAttrsResp, error) {
+	c, err := mysql.GetCategory(ctx, req.GetCategoryId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 上级类目和子类目已定义的属性名
+	ids, err := mysql.GetCategoryTreeIds(ctx, c.Path)
+	if err != nil {
+		return nil, err
+	}
+	ids = append(ids, pathIds(c.Path)...)
+	others, err := mysql.GetCategoryAttrs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(others)+len(req.GetAttrs()))
+	for _, attr := range others {
+		if attr.CategoryId != int64(c.ID) {
+			names[attr.Name] = struct{}{}
+		}
+	}
+
+	attrs := make([]*model.CategoryAttr, 0, len(req.GetAttrs()))
+	for _, schema := range req.GetAttrs() {
+		if !attrNameRe.MatchString(schema.GetName()) {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性名%q只能包含字母、数字和下划线", schema.GetName())}
+		}
+		if _, ok := names[schema.GetName()]; ok {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性名%s重复", schema.GetName())}
+		}
+		names[schema.GetName()] = struct{}{}
+		options, err := attrOptions(schema)
+		if err != nil {
+			return nil, err
+		}
+		attr := &model.CategoryAttr{
+			CategoryId: int64(c.ID),
+			Name:       schema.GetName(),
+			Type:       schema.GetType(),
+			Options:    options,
+			Sort:       int(schema.GetSort()),
+		}
+		if schema.GetRequired() {
+			attr.Required = 1
+		}
+		attr.CreateBy = operator
+		attr.UpdateBy = operator
+		attrs = append(attrs, attr)
+	}
+
+	if err := mysql.SaveCategoryAttrs(ctx, int64(c.ID), attrs); err != nil {
+		return nil, err
+	}
+	return GetCategoryAttrs(ctx, int64(c.ID))
+}
+
+// GetCategoryAttrs 查询类目的属性，包含继承自上级类目的属性
+func GetCategoryAttrs(ctx context.Context, categoryId int64) (*proto.CategoryAttrsResp, error) {
+	attrs, err := categoryAttrs(ctx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.AttrSchema, 0, len(attrs))
+	for _, attr := range attrs {
+		data = append(data, toAttrSchema(attr))
+	}
+	return &proto.CategoryAttrsResp{Data: data}, nil
+}
+
+// categoryAttrs 查询类目及上级类目的属性，上级类目的属性在前
+func categoryAttrs(ctx context.Context, categoryId int64) ([]*model.CategoryAttr, error) {
+	c, err := mysql.GetCategory(ctx, categoryId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := pathIds(c.Path)
+	list, err := mysql.GetCategoryAttrs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	level := make(map[int64]int, len(ids))
+	for i, id := range ids {
+		level[id] = i
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return level[list[i].CategoryId] < level[list[j].CategoryId]
+	})
+	return list, nil
+}
+
+// attrOptions 校验属性类型，enum类型的可选值序列化为json数组
+func attrOptions(schema *proto.AttrSchema) (string, error) {
+	switch schema.GetType() {
+	case model.AttrString, model.AttrNumber, model.AttrBool:
+		if len(schema.GetOptions()) > 0 {
+			return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s只有enum类型可以设置可选值", schema.GetName())}
+		}
+		return "", nil
+	case model.AttrEnum:
+	default:
+		return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s的类型只能为string、number、bool或enum", schema.GetName())}
+	}
+	if len(schema.GetOptions()) == 0 {
+		return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s至少需要一个可选值", schema.GetName())}
+	}
+	seen := make(map[string]struct{}, len(schema.GetOptions()))
+	for _, opt := range schema.GetOptions() {
+		if utf8.RuneCountInString(opt) == 0 || utf8.RuneCountInString(opt) > 64 {
+			return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s的可选值长度需在1-64之间", schema.GetName())}
+		}
+		if _, ok := seen[opt]; ok {
+			return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s的可选值%s重复", schema.GetName(), opt)}
+		}
+		seen[opt] = struct{}{}
+	}
+	b, _ := json.Marshal(schema.GetOptions())
+	if len(b) > 1024 {
+		return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s的可选值总长度不能超过1024", schema.GetName())}
+	}
+	return string(b), nil
+}
+
+// goodsExtJson 按商品所在类目的属性校验扩展属性，并按属性类型序列化为json对象
+func goodsExtJson(ctx context.Context, categoryId int64, values map[string]string) (string, error) {
+	attrs, err := categoryAttrs(ctx, categoryId)
+	if err != nil {
+		return "", err
+	}
+	schemas := make(map[string]*model.CategoryAttr, len(attrs))
+	for _, attr := range attrs {
+		schemas[attr.Name] = attr
+	}
+	for name := range values {
+		if _, ok := schemas[name]; !ok {
+			return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("类目没有属性%s", name)}
+		}
+	}
+
+	ext := make(map[string]interface{}, len(values))
+	for _, attr := range attrs {
+		s, ok := values[attr.Name]
+		if !ok || len(s) == 0 {
+			if attr.Required == 1 {
+				return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s必填", attr.Name)}
+			}
+			continue
+		}
+		v, err := attrValue(attr, s)
+		if err != nil {
+			return "", err
+		}
+		ext[attr.Name] = v
+	}
+	if len(ext) == 0 {
+		return "", nil
+	}
+	b, _ := json.Marshal(ext)
+	if len(b) > maxExtJsonLen {
+		return "", &InvalidGoodsError{"Attrs", fmt.Sprintf("总长度不能超过%d", maxExtJsonLen)}
+	}
+	return string(b), nil
+}
+
+// attrValue 按属性类型解析属性值
+func attrValue(attr *model.CategoryAttr, s string) (interface{}, error) {
+	switch attr.Type {
+	case model.AttrNumber:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s必须是数字", attr.Name)}
+		}
+		return f, nil
+	case model.AttrBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s必须是true或false", attr.Name)}
+		}
+		return b, nil
+	case model.AttrEnum:
+		if !contains(decodeList(attr.Options), s) {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s的值不在可选值中", attr.Name)}
+		}
+		return s, nil
+	default:
+		if utf8.RuneCountInString(s) > 255 {
+			return nil, &InvalidGoodsError{"Attrs", fmt.Sprintf("属性%s长度不能超过255", attr.Name)}
+		}
+		return s, nil
+	}
+}
+
+// checkAttrFilter 校验商品列表的属性过滤条件
+func checkAttrFilter(values map[string]string) error {
+	for name := range values {
+		if !attrNameRe.MatchString(name) {
+			return &InvalidGoodsError{"Attrs", fmt.Sprintf("属性名%q有误", name)}
+		}
+	}
+	return nil
+}
+
+// fillAttrTypes 按类目属性修正扩展属性的类型，enum的值在ext_json中和string一样是字符串，只能按类目属性区分
+// 类目或类目属性查不到时保留按值推断的类型
+func fillAttrTypes(ctx context.Context, list ...*proto.GoodsDetail) {
+	types := make(map[int64]map[string]string)
+	for _, detail := range list {
+		if len(detail.GetAttrs()) == 0 {
+			continue
+		}
+		t, ok := types[detail.GetCategoryId()]
+		if !ok {
+			attrs, err := categoryAttrs(ctx, detail.GetCategoryId())
+			if err != nil && !errors.Is(err, ErrCategoryNotFound) {
+				zap.L().Error("categoryAttrs failed", zap.Int64("category_id", detail.GetCategoryId()), zap.Error(err))
+			}
+			t = make(map[string]string, len(attrs))
+			for _, attr := range attrs {
+				t[attr.Name] = attr.Type
+			}
+			types[detail.GetCategoryId()] = t
+		}
+		for _, attr := range detail.GetAttrs() {
+			if typ, ok := t[attr.GetName()]; ok {
+				attr.Type = typ
+			}
+		}
+	}
+}
+
+// attrValues 把已保存的扩展属性还原为请求中的字符串形式
+func attrValues(s string) map[string]string {
+	list := decodeAttrs(s)
+	values := make(map[string]string, len(list))
+	for _, attr := range list {
+		values[attr.GetName()] = attr.GetValue()
+	}
+	return values
+}
+
+// decodeAttrs 反序列化扩展属性，按属性名排序，非法数据返回空列表
+func decodeAttrs(s string) []*proto.GoodsAttr {
+	var ext map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &ext); err != nil || len(ext) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(ext))
+	for name := range ext {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	data := make([]*proto.GoodsAttr, 0, len(names))
+	for _, name := range names {
+		attr := &proto.GoodsAttr{Name: name}
+		switch v := ext[name].(type) {
+		case float64:
+			attr.Type, attr.Value = model.AttrNumber, strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			attr.Type, attr.Value = model.AttrBool, strconv.FormatBool(v)
+		case string:
+			attr.Type, attr.Value = model.AttrString, v
+		default:
+			continue
+		}
+		data = append(data, attr)
+	}
+	return data
+}
+
+func toAttrSchema(data *model.CategoryAttr) *proto.AttrSchema {
+	return &proto.AttrSchema{
+		Name:       data.Name,
+		Type:       data.Type,
+		Required:   data.Required == 1,
+		Options:    decodeList(data.Options),
+		Sort:       int32(data.Sort),
+		CategoryId: data.CategoryId,
+	}
+}
@@ -0,0 +1,83 @@
+package goods
+
+import (
+	"context"
+	"good_service/dao/cache"
+	"good_service/model"
+	"good_service/proto"
+	"time"
+)
+
+// BatchGetGoodsDetail 批量查询商品详情，结果与请求一一对应，不存在的商品标记NotFound
+// 本地缓存未命中的商品一次批量回源，同一直播间的秒杀场次只查询一次
+func BatchGetGoodsDetail(ctx context.Context, items []*proto.GoodsDetailKey) (*proto.BatchGetGoodsDetailResp, error) {
+	now := time.Now()
+	data := make([]*proto.BatchGoodsDetail, len(items))
+	missing := make([]int, 0, len(items))
+	for i, item := range items {
+		data[i] = &proto.BatchGoodsDetail{GoodsId: item.GetGoodsId(), RoomId: item.GetRoomId()}
+		if local == nil {
+			missing = append(missing, i)
+			continue
+		}
+		key := detailKey{goodsId: item.GetGoodsId(), roomId: item.GetRoomId()}
+		if v := local.get(local.detail, "detail", key, now); v != nil {
+			detail := v.(*proto.GoodsDetail)
+			detail.FlashSale = refreshFlashSale(detail.FlashSale, now)
+			data[i].Detail = detail
+			continue
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return &proto.BatchGetGoodsDetailResp{Data: data}, nil
+	}
+
+	idList := make([]int64, 0, len(missing))
+	roomGoods := make(map[int64][]int64)
+	for _, i := range missing {
+		idList = append(idList, items[i].GetGoodsId())
+		roomGoods[items[i].GetRoomId()] = append(roomGoods[items[i].GetRoomId()], items[i].GetGoodsId())
+	}
+	list, err := cache.GetGoodsList(ctx, uniqueIds(idList))
+	if err != nil {
+		return nil, err
+	}
+	goodsMap := make(map[int64]*model.Goods, len(list))
+	for _, goods := range list {
+		goodsMap[goods.GoodsId] = goods
+	}
+	flashSale := make(map[int64]map[int64]*proto.FlashSaleInfo, len(roomGoods))
+	for roomId, ids := range roomGoods {
+		fs, err := getFlashSaleMap(ctx, roomId, ids, now)
+		if err != nil {
+			return nil, err
+		}
+		flashSale[roomId] = fs
+	}
+
+	built := make(map[detailKey]*proto.GoodsDetail, len(missing))
+	for _, i := range missing {
+		item := data[i]
+		goods, ok := goodsMap[item.GoodsId]
+		if !ok {
+			item.NotFound = true
+			continue
+		}
+		key := detailKey{goodsId: item.GoodsId, roomId: item.RoomId}
+		if detail, ok := built[key]; ok {
+			item.Detail = detail
+			continue
+		}
+		detail, err := buildGoodsDetail(ctx, goods, flashSale[item.RoomId][item.GoodsId])
+		if err != nil {
+			return nil, err
+		}
+		if local != nil {
+			local.add(local.detail, key, detail, now)
+		}
+		built[key] = detail
+		item.Detail = detail
+	}
+	return &proto.BatchGetGoodsDetailResp{Data: data}, nil
+}
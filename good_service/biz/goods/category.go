@@ -0,0 +1,324 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("类目不存在")
+	ErrBrandNotFound    = errors.New("品牌不存在")
+)
+
+// 类目最多三级
+const maxCategoryLevel = 3
+
+// CreateCategory 创建类目，ParentId为0时创建一级类目
+func CreateCategory(ctx context.Context, req *proto.CategoryReq, operator string) (*proto.CategoryInfo, error) {
+	if err := checkCategoryReq(req); err != nil {
+		return nil, err
+	}
+	level, parentPath := 1, "/"
+	if req.GetParentId() > 0 {
+		parent, err := mysql.GetCategory(ctx, req.GetParentId())
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.Level >= maxCategoryLevel {
+			return nil, &InvalidGoodsError{"ParentId", "类目最多三级"}
+		}
+		level, parentPath = parent.Level+1, parent.Path
+	}
+	if err := checkCategoryName(ctx, req.GetParentId(), req.GetName(), 0); err != nil {
+		return nil, err
+	}
+
+	data := &model.Category{
+		ParentId: req.GetParentId(),
+		Name:     req.GetName(),
+		Level:    level,
+		Sort:     int(req.GetSort()),
+		Status:   int8(req.GetStatus()),
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	if err := mysql.CreateCategory(ctx, data, parentPath); err != nil {
+		return nil, err
+	}
+	return toCategoryInfo(data), nil
+}
+
+// UpdateCategory 修改类目名称、排序和启用状态，不支持移动类目
+func UpdateCategory(ctx context.Context, req *proto.CategoryReq, operator string) (*proto.CategoryInfo, error) {
+	if err := checkCategoryReq(req); err != nil {
+		return nil, err
+	}
+	data, err := mysql.GetCategory(ctx, req.GetCategoryId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCategoryName(ctx, data.ParentId, req.GetName(), req.GetCategoryId()); err != nil {
+		return nil, err
+	}
+
+	data.Name = req.GetName()
+	data.Sort = int(req.GetSort())
+	data.Status = int8(req.GetStatus())
+	data.UpdateBy = operator
+	if err := mysql.UpdateCategory(ctx, data); err != nil {
+		return nil, err
+	}
+	return toCategoryInfo(data), nil
+}
+
+// CategoryTree 查询类目树，默认不返回停用的类目及其子类目
+func CategoryTree(ctx context.Context, includeDisabled bool) (*proto.CategoryTreeResp, error) {
+	list, err := mysql.GetAllCategory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 类目按层级排序，父类目总是先于子类目处理
+	nodes := make(map[int64]*proto.CategoryInfo, len(list))
+	data := make([]*proto.CategoryInfo, 0)
+	for _, c := range list {
+		if c.Status != 0 && !includeDisabled {
+			continue
+		}
+		node := toCategoryInfo(c)
+		if c.ParentId == 0 {
+			data = append(data, node)
+		} else if parent, ok := nodes[c.ParentId]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			// 父类目已停用，整棵子树都不返回
+			continue
+		}
+		nodes[int64(c.ID)] = node
+	}
+	return &proto.CategoryTreeResp{Data: data}, nil
+}
+
+// categoryTreeIds 查询类目及其所有子类目的id，类目不存在时返回空
+func categoryTreeIds(ctx context.Context, categoryId int64) ([]int64, error) {
+	c, err := mysql.GetCategory(ctx, categoryId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mysql.GetCategoryTreeIds(ctx, c.Path)
+}
+
+// checkGoodsCategory 商品只能挂在启用的叶子类目下，且所有上级类目都要启用
+func checkGoodsCategory(ctx context.Context, categoryId int64) error {
+	if categoryId <= 0 {
+		return &InvalidGoodsError{"CategoryId", "不能为空"}
+	}
+	c, err := mysql.GetCategory(ctx, categoryId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCategoryNotFound
+	}
+	if err != nil {
+		return err
+	}
+	hasChild, err := mysql.ExistChildCategory(ctx, categoryId)
+	if err != nil {
+		return err
+	}
+	if hasChild {
+		return &InvalidGoodsError{"CategoryId", "必须是末级类目"}
+	}
+
+	ids := pathIds(c.Path)
+	list, err := mysql.GetCategoryByIds(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(list) != len(ids) {
+		return ErrCategoryNotFound
+	}
+	for _, item := range list {
+		if item.Status != 0 {
+			return &InvalidGoodsError{"CategoryId", "类目已停用"}
+		}
+	}
+	return nil
+}
+
+// checkGoodsBrand 填写了品牌时，品牌必须存在且已启用
+func checkGoodsBrand(ctx context.Context, name string) error {
+	if len(name) == 0 {
+		return nil
+	}
+	b, err := mysql.GetBrandByName(ctx, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrBrandNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if b.Status != 0 {
+		return &InvalidGoodsError{"BrandName", "品牌已停用"}
+	}
+	return nil
+}
+
+func checkCategoryReq(req *proto.CategoryReq) error {
+	if n := utf8.RuneCountInString(req.GetName()); n == 0 || n > 64 {
+		return &InvalidGoodsError{"Name", "长度需在1-64之间"}
+	}
+	if req.GetStatus() != 0 && req.GetStatus() != 1 {
+		return &InvalidGoodsError{"Status", "只能为0或1"}
+	}
+	return nil
+}
+
+// checkCategoryName 同级类目不能重名
+func checkCategoryName(ctx context.Context, parentId int64, name string, excludeId int64) error {
+	exist, err := mysql.ExistCategoryName(ctx, parentId, name, excludeId)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return &InvalidGoodsError{"Name", "同级类目已存在"}
+	}
+	return nil
+}
+
+// pathIds 解析类目路径中的id，如/1/5/12/返回[1 5 12]
+func pathIds(path string) []int64 {
+	var ids []int64
+	for _, s := range strings.Split(strings.Trim(path, "/"), "/") {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func toCategoryInfo(data *model.Category) *proto.CategoryInfo {
+	return &proto.CategoryInfo{
+		CategoryId: int64(data.ID),
+		ParentId:   data.ParentId,
+		Name:       data.Name,
+		Level:      int32(data.Level),
+		Sort:       int32(data.Sort),
+		Status:     int32(data.Status),
+	}
+}
+
+// CreateBrand 创建品牌
+func CreateBrand(ctx context.Context, req *proto.BrandReq, operator string) (*proto.BrandInfo, error) {
+	if err := checkBrandReq(ctx, req); err != nil {
+		return nil, err
+	}
+	data := &model.Brand{
+		Name:   req.GetName(),
+		Logo:   req.GetLogo(),
+		Status: int8(req.GetStatus()),
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	if err := mysql.CreateBrand(ctx, data); err != nil {
+		return nil, err
+	}
+	return toBrandInfo(data), nil
+}
+
+// UpdateBrand 修改品牌，已关联商品的品牌名称不会同步修改
+func UpdateBrand(ctx context.Context, req *proto.BrandReq, operator string) (*proto.BrandInfo, error) {
+	data, err := mysql.GetBrand(ctx, req.GetBrandId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBrandNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBrandReq(ctx, req); err != nil {
+		return nil, err
+	}
+	oldName := data.Name
+	data.Name = req.GetName()
+	data.Logo = req.GetLogo()
+	data.Status = int8(req.GetStatus())
+	data.UpdateBy = operator
+	goodsIds, err := mysql.UpdateBrand(ctx, data, oldName)
+	if err != nil {
+		return nil, err
+	}
+	if len(goodsIds) > 0 {
+		cache.DelGoods(ctx, goodsIds...)
+	}
+	return toBrandInfo(data), nil
+}
+
+// ListBrand 按名称和状态分页查询品牌
+func ListBrand(ctx context.Context, req *proto.ListBrandReq) (*proto.ListBrandResp, error) {
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	list, total, err := mysql.ListBrand(ctx, req.GetName(), req.Status, (pageNum-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.BrandInfo, 0, len(list))
+	for _, b := range list {
+		data = append(data, toBrandInfo(b))
+	}
+	return &proto.ListBrandResp{Total: total, Data: data}, nil
+}
+
+func checkBrandReq(ctx context.Context, req *proto.BrandReq) error {
+	if n := utf8.RuneCountInString(req.GetName()); n == 0 || n > 255 {
+		return &InvalidGoodsError{"Name", "长度需在1-255之间"}
+	}
+	if req.GetStatus() != 0 && req.GetStatus() != 1 {
+		return &InvalidGoodsError{"Status", "只能为0或1"}
+	}
+	if len(req.GetLogo()) > 255 {
+		return &InvalidGoodsError{"Logo", "长度不能超过255"}
+	}
+	if len(req.GetLogo()) > 0 {
+		if _, err := encodeList("Logo", []string{req.GetLogo()}, true, maxHeadImgsLen); err != nil {
+			return err
+		}
+	}
+	b, err := mysql.GetBrandByName(ctx, req.GetName())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil && int64(b.ID) != req.GetBrandId() {
+		return &InvalidGoodsError{"Name", "品牌已存在"}
+	}
+	return nil
+}
+
+func toBrandInfo(data *model.Brand) *proto.BrandInfo {
+	return &proto.BrandInfo{
+		BrandId: int64(data.ID),
+		Name:    data.Name,
+		Logo:    data.Logo,
+		Status:  int32(data.Status),
+	}
+}
@@ -0,0 +1,108 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"money"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrGoodsNotInRoom     = errors.New("商品不在该直播间")
+	ErrFlashSaleOverlap   = errors.New("该时间段内已有秒杀场次")
+	ErrFlashSaleTimeRange = errors.New("秒杀时间范围有误")
+)
+
+// CreateFlashSale 创建直播间商品秒杀场次
+func CreateFlashSale(ctx context.Context, req *proto.CreateFlashSaleReq) (*proto.FlashSaleInfo, error) {
+	start := time.Unix(req.GetStartTime(), 0)
+	end := time.Unix(req.GetEndTime(), 0)
+	now := time.Now()
+	if !end.After(start) || !end.After(now) {
+		return nil, ErrFlashSaleTimeRange
+	}
+
+	// 秒杀商品必须已挂在直播间
+	_, err := mysql.GetRoomGoods(ctx, req.GetRoomId(), req.GetGoodsId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGoodsNotInRoom
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一商品的场次时间不能重叠
+	exist, err := mysql.ExistFlashSaleOverlap(ctx, req.GetRoomId(), req.GetGoodsId(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, ErrFlashSaleOverlap
+	}
+
+	data := &model.FlashSale{
+		RoomId:    req.GetRoomId(),
+		GoodsId:   req.GetGoodsId(),
+		Price:     req.GetPrice(),
+		StartTime: start,
+		EndTime:   end,
+	}
+	if err := mysql.CreateFlashSale(ctx, data); err != nil {
+		return nil, err
+	}
+	cache.DelRoomFlashSale(ctx, data.RoomId)
+	return toFlashSaleInfo(data, now), nil
+}
+
+// getFlashSaleMap 查询直播间商品当前或即将开始的秒杀场次，key为goodsId
+func getFlashSaleMap(ctx context.Context, roomId int64, idList []int64, now time.Time) (map[int64]*proto.FlashSaleInfo, error) {
+	res := make(map[int64]*proto.FlashSaleInfo, len(idList))
+	if roomId <= 0 || len(idList) == 0 {
+		return res, nil
+	}
+	list, err := cache.GetRoomFlashSale(ctx, roomId, now)
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[int64]struct{}, len(idList))
+	for _, id := range idList {
+		want[id] = struct{}{}
+	}
+	// 场次按开始时间升序，每个商品只取最早的一场
+	for _, fs := range list {
+		if _, ok := want[fs.GoodsId]; !ok {
+			continue
+		}
+		if _, ok := res[fs.GoodsId]; ok {
+			continue
+		}
+		res[fs.GoodsId] = toFlashSaleInfo(fs, now)
+	}
+	return res, nil
+}
+
+func toFlashSaleInfo(fs *model.FlashSale, now time.Time) *proto.FlashSaleInfo {
+	info := &proto.FlashSaleInfo{
+		SessionId: int64(fs.ID),
+		RoomId:    fs.RoomId,
+		GoodsId:   fs.GoodsId,
+		Price:     money.FromCent(fs.Price).String(),
+		PriceCent: fs.Price,
+		StartTime: fs.StartTime.Unix(),
+		EndTime:   fs.EndTime.Unix(),
+	}
+	if now.Before(fs.StartTime) {
+		info.Status = 0
+		info.Countdown = int64(fs.StartTime.Sub(now).Seconds())
+	} else {
+		info.Status = 1
+		info.Countdown = int64(fs.EndTime.Sub(now).Seconds())
+	}
+	return info
+}
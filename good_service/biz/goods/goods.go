@@ -2,34 +2,102 @@ package goods
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"errors"
+	"good_service/dao/cache"
 	"good_service/dao/mysql"
+	"good_service/model"
 	"good_service/proto"
+	"money"
+	"time"
 )
 
-func GetGoodsDetail(ctx context.Context, goodId int64) (*proto.GoodsDetail, error) {
-	data, err := mysql.GetGoodsDetail(ctx, goodId)
+func GetGoodsDetail(ctx context.Context, goodId, roomId int64) (*proto.GoodsDetail, error) {
+	if local == nil {
+		return getGoodsDetail(ctx, goodId, roomId)
+	}
+	now := time.Now()
+	key := detailKey{goodsId: goodId, roomId: roomId}
+	if v := local.get(local.detail, "detail", key, now); v != nil {
+		resp := v.(*proto.GoodsDetail)
+		resp.FlashSale = refreshFlashSale(resp.FlashSale, now)
+		return resp, nil
+	}
+	resp, err := getGoodsDetail(ctx, goodId, roomId)
+	if err != nil {
+		return nil, err
+	}
+	local.add(local.detail, key, resp, now)
+	return resp, nil
+}
+
+func getGoodsDetail(ctx context.Context, goodId, roomId int64) (*proto.GoodsDetail, error) {
+	data, err := cache.GetGoods(ctx, goodId)
+	if errors.Is(err, mysql.ErrGoodsNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 带上直播间的秒杀场次
+	flashSale, err := getFlashSaleMap(ctx, roomId, []int64{data.GoodsId}, time.Now())
 	if err != nil {
 		return nil, err
 	}
+	return buildGoodsDetail(ctx, data, flashSale[data.GoodsId])
+}
+
+// buildGoodsDetail 组装商品详情，带上秒杀场次、规格和库存、定时调价
+func buildGoodsDetail(ctx context.Context, data *model.Goods, flashSale *proto.FlashSaleInfo) (*proto.GoodsDetail, error) {
+	resp := toGoodsDetail(data)
+	resp.FlashSale = flashSale
+	fillAttrTypes(ctx, resp)
+
+	// 带上规格和每个规格的可用库存
+	if err := attachSku(ctx, resp); err != nil {
+		return nil, err
+	}
+	if err := attachPricePlan(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
-	resp := &proto.GoodsDetail{
-		GoodsId: data.GoodsId,
+// GetGoodsByRoomId 直播间商品列表，按销量排序时实时查询直播间销量后重新排序
+func GetGoodsByRoomId(ctx context.Context, roomId int64, sortBy int32) (*proto.GoodsListResp, error) {
+	resp, err := roomGoodsList(ctx, roomId)
+	if err != nil || sortBy != RoomSortSales {
+		return resp, err
+	}
+	if err := sortBySales(ctx, roomId, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
-		CategoryId:  data.CategoryId,
-		Status:      int32(data.Status),
-		Title:       data.Title,
-		MarketPrice: fmt.Sprintf("%.2f", float64(data.MarketPrice/100)),
-		Price:       fmt.Sprintf("%.2f", float64(data.Price/100)),
-		Brief:       data.Brief,
+func roomGoodsList(ctx context.Context, roomId int64) (*proto.GoodsListResp, error) {
+	if local == nil {
+		return getGoodsByRoomId(ctx, roomId)
+	}
+	now := time.Now()
+	if v := local.get(local.list, "list", roomId, now); v != nil {
+		resp := v.(*proto.GoodsListResp)
+		for _, info := range resp.Data {
+			info.FlashSale = refreshFlashSale(info.FlashSale, now)
+		}
+		return resp, nil
+	}
+	resp, err := getGoodsByRoomId(ctx, roomId)
+	if err != nil {
+		return nil, err
 	}
+	local.add(local.list, roomId, resp, now)
 	return resp, nil
 }
 
-func GetGoodsByRoomId(ctx context.Context, roomId int64) (*proto.GoodsListResp, error) {
+func getGoodsByRoomId(ctx context.Context, roomId int64) (*proto.GoodsListResp, error) {
 	// 查询出roomId下所有的goodsId
-	objList, err := mysql.GetGoodsByRoomId(ctx, roomId)
+	objList, err := cache.GetRoomGoods(ctx, roomId)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +117,17 @@ func GetGoodsByRoomId(ctx context.Context, roomId int64) (*proto.GoodsListResp,
 		}
 	}
 
-	goodsList, err := mysql.GetGoodsById(ctx, idList)
+	// 已下架的商品不展示，正在讲解的商品下架时也不再返回
+	goodsList, err := onShelfGoods(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	if !containsGoods(goodsList, currGoodsId) {
+		currGoodsId = 0
+	}
+
+	// 查询商品的秒杀场次
+	flashSale, err := getFlashSaleMap(ctx, roomId, idList, time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -57,18 +135,7 @@ func GetGoodsByRoomId(ctx context.Context, roomId int64) (*proto.GoodsListResp,
 	//拼装响应数据
 	data := make([]*proto.GoodsInfo, 0, len(goodsList))
 	for _, goods := range goodsList {
-		var headImgs []string
-		json.Unmarshal([]byte(goods.HeadImgs), &headImgs)
-		data = append(data, &proto.GoodsInfo{
-			GoodsId:     goods.GoodsId,
-			CategoryId:  goods.CategoryId,
-			Status:      int32(goods.Status),
-			Title:       goods.Title,
-			MarketPrice: fmt.Sprintf("%.2f", float64(goods.MarketPrice/100)),
-			Price:       fmt.Sprintf("%.2f", float64(goods.Price/100)),
-			Brief:       goods.Brief,
-			HeadImgs:    headImgs,
-		})
+		data = append(data, toGoodsInfo(goods, flashSale[goods.GoodsId]))
 	}
 
 	resp := &proto.GoodsListResp{
@@ -78,3 +145,24 @@ func GetGoodsByRoomId(ctx context.Context, roomId int64) (*proto.GoodsListResp,
 	return resp, nil
 
 }
+
+func toGoodsInfo(goods *model.Goods, flashSale *proto.FlashSaleInfo) *proto.GoodsInfo {
+	headImgs, headThumbs := goodsHeadImgs(goods)
+	return &proto.GoodsInfo{
+		GoodsId:         goods.GoodsId,
+		CategoryId:      goods.CategoryId,
+		Status:          int32(goods.Status),
+		Title:           goods.Title,
+		MarketPrice:     money.FromCent(goods.MarketPrice).String(),
+		Price:           money.FromCent(goods.Price).String(),
+		MarketPriceCent: goods.MarketPrice,
+		PriceCent:       goods.Price,
+		Brief:           goods.Brief,
+		HeadImgs:        headImgs,
+		HeadThumbs:      headThumbs,
+		Rating:          goodsRating(goods),
+		RatingCount:     goods.ReviewCount,
+		Sales:           goods.Sales,
+		FlashSale:       flashSale,
+	}
+}
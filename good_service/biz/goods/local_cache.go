@@ -0,0 +1,118 @@
+package goods
+
+import (
+	"expvar"
+	"good_service/config"
+	"good_service/dao/cache"
+	"good_service/proto"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	pb "google.golang.org/protobuf/proto"
+)
+
+// 本地缓存的命中统计，通过本机管理端口的/debug/vars查看
+var localStats = expvar.NewMap("goods_local_cache")
+
+type detailKey struct {
+	goodsId int64
+	roomId  int64
+}
+
+type localEntry struct {
+	value    pb.Message
+	expireAt time.Time
+}
+
+// localCache 进程内的二级缓存，缓存直播间商品列表和商品详情的响应
+type localCache struct {
+	list   *lru.Cache // key为roomId
+	detail *lru.Cache // key为detailKey
+	expire time.Duration
+}
+
+var local *localCache
+
+// InitLocalCache 创建本地缓存，并订阅其他实例的缓存失效通知
+func InitLocalCache(cfg *config.LocalCacheConfig) error {
+	list, err := lru.New(cfg.ListSize)
+	if err != nil {
+		return err
+	}
+	detail, err := lru.New(cfg.DetailSize)
+	if err != nil {
+		return err
+	}
+	local = &localCache{
+		list:   list,
+		detail: detail,
+		expire: time.Duration(cfg.Expire) * time.Second,
+	}
+	cache.OnInvalidate(local.invalidate)
+	return nil
+}
+
+func (c *localCache) get(l *lru.Cache, name string, key interface{}, now time.Time) pb.Message {
+	v, ok := l.Get(key)
+	if ok && now.Before(v.(*localEntry).expireAt) {
+		localStats.Add(name+"_hit", 1)
+		// 返回副本，调用方会修改秒杀倒计时
+		return pb.Clone(v.(*localEntry).value)
+	}
+	localStats.Add(name+"_miss", 1)
+	return nil
+}
+
+func (c *localCache) add(l *lru.Cache, key interface{}, value pb.Message, now time.Time) {
+	if l.Add(key, &localEntry{value: pb.Clone(value), expireAt: now.Add(c.expire)}) {
+		localStats.Add("evict", 1)
+	}
+}
+
+// invalidate 商品变化时清除包含该商品的列表和详情，直播间变化时清除该直播间的列表和详情
+func (c *localCache) invalidate(inv *cache.Invalidation) {
+	localStats.Add("invalidate", 1)
+	goodsIds := make(map[int64]struct{}, len(inv.GoodsIds))
+	for _, id := range inv.GoodsIds {
+		goodsIds[id] = struct{}{}
+	}
+
+	if inv.RoomId > 0 {
+		c.list.Remove(inv.RoomId)
+	}
+	if len(goodsIds) > 0 {
+		for _, key := range c.list.Keys() {
+			v, ok := c.list.Peek(key)
+			if !ok {
+				continue
+			}
+			for _, info := range v.(*localEntry).value.(*proto.GoodsListResp).GetData() {
+				if _, ok := goodsIds[info.GetGoodsId()]; ok {
+					c.list.Remove(key)
+					break
+				}
+			}
+		}
+	}
+	for _, key := range c.detail.Keys() {
+		k := key.(detailKey)
+		if _, ok := goodsIds[k.goodsId]; ok || (inv.RoomId > 0 && k.roomId == inv.RoomId) {
+			c.detail.Remove(key)
+		}
+	}
+}
+
+// refreshFlashSale 按当前时间重新计算缓存中秒杀场次的状态和倒计时，已结束的场次返回nil
+func refreshFlashSale(info *proto.FlashSaleInfo, now time.Time) *proto.FlashSaleInfo {
+	if info == nil || now.Unix() >= info.GetEndTime() {
+		return nil
+	}
+	if now.Unix() < info.GetStartTime() {
+		info.Status = 0
+		info.Countdown = info.GetStartTime() - now.Unix()
+	} else {
+		info.Status = 1
+		info.Countdown = info.GetEndTime() - now.Unix()
+	}
+	return info
+}
@@ -0,0 +1,261 @@
+package goods
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"good_service/config"
+	"good_service/dao/blob"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"good_service/third_party/snowflake"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"os"
+
+	"go.uber.org/zap"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// 允许上传的文件类型，按文件内容识别，不信任客户端传的扩展名
+var mediaKinds = map[string]int8{
+	"image/jpeg": model.MediaImage,
+	"image/png":  model.MediaImage,
+	"image/gif":  model.MediaImage,
+	"image/webp": model.MediaImage,
+	"video/mp4":  model.MediaVideo,
+	"video/webm": model.MediaVideo,
+}
+
+const (
+	maxHeadImgIds  = 9
+	maxVideoIds    = 3
+	maxImagePixels = 50 * 1000 * 1000 // 解码前校验像素数，避免超大图片占满内存
+)
+
+func mediaObjectKey(mediaId int64) string {
+	return fmt.Sprintf("media/%d", mediaId)
+}
+
+func mediaThumbKey(mediaId int64) string {
+	return fmt.Sprintf("thumb/%d", mediaId)
+}
+
+// UploadMedia 保存上传的图片或视频，图片同时生成缩略图
+// 文件先落到临时文件，校验类型和大小后再写入存储
+func UploadMedia(ctx context.Context, r io.Reader, operator string) (*proto.MediaInfo, error) {
+	cfg := config.Conf.MediaConfig
+	maxSize := cfg.MaxImageSize
+	if cfg.MaxVideoSize > maxSize {
+		maxSize = cfg.MaxVideoSize
+	}
+	maxSize <<= 20
+
+	f, err := os.CreateTemp("", "media-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+	size, err := io.Copy(f, io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, &InvalidGoodsError{"File", "不能为空"}
+	}
+	if size > maxSize {
+		return nil, &InvalidGoodsError{"File", fmt.Sprintf("不能超过%dMB", maxSize>>20)}
+	}
+
+	head := make([]byte, 512)
+	n, err := f.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	contentType := http.DetectContentType(head[:n])
+	kind, ok := mediaKinds[contentType]
+	if !ok {
+		return nil, &InvalidGoodsError{"File", "类型不支持，仅支持jpeg/png/gif/webp图片和mp4/webm视频"}
+	}
+	if kind == model.MediaImage && size > cfg.MaxImageSize<<20 {
+		return nil, &InvalidGoodsError{"File", fmt.Sprintf("图片不能超过%dMB", cfg.MaxImageSize)}
+	}
+	if kind == model.MediaVideo && size > cfg.MaxVideoSize<<20 {
+		return nil, &InvalidGoodsError{"File", fmt.Sprintf("视频不能超过%dMB", cfg.MaxVideoSize)}
+	}
+
+	data := &model.Media{
+		MediaId:     snowflake.GenID(),
+		Kind:        kind,
+		ContentType: contentType,
+		Size:        size,
+	}
+	data.ObjectKey = mediaObjectKey(data.MediaId)
+	data.CreateBy = operator
+	data.UpdateBy = operator
+
+	if kind == model.MediaImage {
+		thumb, err := imageThumb(f, data, cfg.ThumbSize)
+		if err != nil {
+			return nil, err
+		}
+		data.ThumbKey = mediaThumbKey(data.MediaId)
+		if err := blob.Put(ctx, data.ThumbKey, bytes.NewReader(thumb), int64(len(thumb)), "image/jpeg"); err != nil {
+			return nil, err
+		}
+	}
+	if err := blob.Put(ctx, data.ObjectKey, io.NewSectionReader(f, 0, size), size, contentType); err != nil {
+		deleteMediaFiles(ctx, data)
+		return nil, err
+	}
+	if err := mysql.CreateMedia(ctx, data); err != nil {
+		deleteMediaFiles(ctx, data)
+		return nil, err
+	}
+	return toMediaInfo(data), nil
+}
+
+// imageThumb 校验图片能正常解码，记录宽高，并按最长边缩放生成jpeg缩略图，小图不放大
+func imageThumb(f *os.File, data *model.Media, thumbSize int) ([]byte, error) {
+	conf, _, err := image.DecodeConfig(io.NewSectionReader(f, 0, data.Size))
+	if err != nil {
+		return nil, &InvalidGoodsError{"File", "不是有效的图片"}
+	}
+	if conf.Width <= 0 || conf.Height <= 0 || conf.Width*conf.Height > maxImagePixels {
+		return nil, &InvalidGoodsError{"File", "图片尺寸过大"}
+	}
+	data.Width, data.Height = conf.Width, conf.Height
+
+	src, _, err := image.Decode(io.NewSectionReader(f, 0, data.Size))
+	if err != nil {
+		return nil, &InvalidGoodsError{"File", "不是有效的图片"}
+	}
+	w, h := conf.Width, conf.Height
+	if thumbSize > 0 && (w > thumbSize || h > thumbSize) {
+		if w >= h {
+			w, h = thumbSize, h*thumbSize/conf.Width
+		} else {
+			w, h = w*thumbSize/conf.Height, thumbSize
+		}
+		if w == 0 {
+			w = 1
+		}
+		if h == 0 {
+			h = 1
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	// 透明背景的png和gif转jpeg时先铺白底
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deleteMediaFiles 上传失败时清理已写入存储的文件
+func deleteMediaFiles(ctx context.Context, data *model.Media) {
+	for _, key := range []string{data.ObjectKey, data.ThumbKey} {
+		if key == "" {
+			continue
+		}
+		if err := blob.Delete(ctx, key); err != nil {
+			zap.L().Warn("blob.Delete failed", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+func toMediaInfo(data *model.Media) *proto.MediaInfo {
+	info := &proto.MediaInfo{
+		MediaId:     data.MediaId,
+		Kind:        int32(data.Kind),
+		ContentType: data.ContentType,
+		Size:        data.Size,
+		Width:       int32(data.Width),
+		Height:      int32(data.Height),
+		Url:         blob.URL(data.ObjectKey),
+	}
+	if data.ThumbKey != "" {
+		info.ThumbUrl = blob.URL(data.ThumbKey)
+	}
+	return info
+}
+
+// encodeMediaIds 校验媒体id列表并序列化为json数组
+func encodeMediaIds(field string, idList []int64, maxCount int) (string, error) {
+	if len(idList) == 0 {
+		return "", nil
+	}
+	if len(idList) > maxCount {
+		return "", &InvalidGoodsError{field, fmt.Sprintf("不能超过%d个", maxCount)}
+	}
+	seen := make(map[int64]struct{}, len(idList))
+	for _, id := range idList {
+		if id <= 0 {
+			return "", &InvalidGoodsError{field, "有误"}
+		}
+		if _, ok := seen[id]; ok {
+			return "", &InvalidGoodsError{field, "不能重复"}
+		}
+		seen[id] = struct{}{}
+	}
+	b, _ := json.Marshal(idList)
+	return string(b), nil
+}
+
+// decodeMediaIds 反序列化媒体id列表，非法数据返回空列表
+func decodeMediaIds(s string) []int64 {
+	var idList []int64
+	json.Unmarshal([]byte(s), &idList)
+	return idList
+}
+
+// checkGoodsMedia 校验商品引用的媒体都已上传，头图只能是图片，视频只能是视频
+func checkGoodsMedia(ctx context.Context, req *proto.GoodsReq) error {
+	if err := checkMediaKind(ctx, "HeadImgIds", req.GetHeadImgIds(), model.MediaImage); err != nil {
+		return err
+	}
+	return checkMediaKind(ctx, "VideoIds", req.GetVideoIds(), model.MediaVideo)
+}
+
+// goodsHeadImgs 商品头图和缩略图的地址，引用了媒体时按媒体id生成，否则用直接传入的地址，缩略图即原图
+func goodsHeadImgs(data *model.Goods) (headImgs, thumbs []string) {
+	idList := decodeMediaIds(data.HeadImgIds)
+	if len(idList) == 0 {
+		headImgs = decodeList(data.HeadImgs)
+		return headImgs, headImgs
+	}
+	headImgs = make([]string, 0, len(idList))
+	thumbs = make([]string, 0, len(idList))
+	for _, id := range idList {
+		headImgs = append(headImgs, blob.URL(mediaObjectKey(id)))
+		thumbs = append(thumbs, blob.URL(mediaThumbKey(id)))
+	}
+	return headImgs, thumbs
+}
+
+// goodsVideos 商品视频的地址，引用了媒体时按媒体id生成
+func goodsVideos(data *model.Goods) []string {
+	idList := decodeMediaIds(data.VideoIds)
+	if len(idList) == 0 {
+		return decodeList(data.Videos)
+	}
+	videos := make([]string, 0, len(idList))
+	for _, id := range idList {
+		videos = append(videos, blob.URL(mediaObjectKey(id)))
+	}
+	return videos
+}
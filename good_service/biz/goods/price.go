@@ -0,0 +1,141 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 每次扫描处理的到期调价数
+const priceBatchSize = 200
+
+// SchedulePrice 创建定时调价，有规格的商品按规格调价
+func SchedulePrice(ctx context.Context, req *proto.SchedulePriceReq, operator string) (*proto.PriceChangeInfo, error) {
+	if req.GetPriceCent() <= 0 || req.GetMarketPriceCent() < 0 {
+		return nil, &InvalidGoodsError{"Price", "有误"}
+	}
+	if req.GetEffectiveAt() <= time.Now().Unix() {
+		return nil, &InvalidGoodsError{"EffectiveAt", "必须晚于当前时间"}
+	}
+	_, err := mysql.GetGoodsByGoodsId(ctx, req.GetGoodsId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	skus, err := mysql.GetSkusByGoodsId(ctx, req.GetGoodsId())
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) > 0 && !hasSku(skus, req.GetSkuId()) {
+		return nil, &InvalidGoodsError{"SkuId", "规格不存在"}
+	}
+	if len(skus) == 0 && req.GetSkuId() != 0 {
+		return nil, &InvalidGoodsError{"SkuId", "商品没有规格"}
+	}
+
+	data := &model.GoodsPrice{
+		GoodsId:     req.GetGoodsId(),
+		SkuId:       req.GetSkuId(),
+		Price:       req.GetPriceCent(),
+		MarketPrice: req.GetMarketPriceCent(),
+		EffectiveAt: time.Unix(req.GetEffectiveAt(), 0),
+		Status:      model.PriceScheduled,
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	if err := mysql.CreatePrice(ctx, data); err != nil {
+		return nil, err
+	}
+	cache.DelGoods(ctx, data.GoodsId)
+	return toPriceChangeInfo(data), nil
+}
+
+// GetPriceHistory 分页查询商品的价格变更记录，包含待生效的定时调价
+func GetPriceHistory(ctx context.Context, req *proto.GetPriceHistoryReq) (*proto.PriceHistoryResp, error) {
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	list, total, err := mysql.GetPriceHistory(ctx, req.GetGoodsId(), req.SkuId, (pageNum-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.PriceChangeInfo, 0, len(list))
+	for _, p := range list {
+		data = append(data, toPriceChangeInfo(p))
+	}
+	return &proto.PriceHistoryResp{Total: total, Data: data}, nil
+}
+
+// applyPriceSchedule 处理一批到期的定时调价
+func applyPriceSchedule(ctx context.Context, now time.Time) error {
+	list, err := mysql.GetDuePrices(ctx, now, priceBatchSize)
+	if err != nil {
+		return err
+	}
+	changed := make([]int64, 0, len(list))
+	for _, data := range list {
+		status, err := mysql.ApplyPrice(ctx, data)
+		if err != nil {
+			zap.L().Error("mysql.ApplyPrice failed", zap.Uint("id", data.ID), zap.Int64("goods_id", data.GoodsId), zap.Error(err))
+			continue
+		}
+		// 已被其他实例处理
+		if status == model.PriceScheduled {
+			continue
+		}
+		zap.L().Info("goods price schedule handled", zap.Uint("id", data.ID), zap.Int64("goods_id", data.GoodsId),
+			zap.Int64("sku_id", data.SkuId), zap.Int8("status", status))
+		changed = append(changed, data.GoodsId)
+	}
+	if len(changed) > 0 {
+		cache.DelGoods(ctx, uniqueIds(changed)...)
+	}
+	return nil
+}
+
+// attachPricePlan 带上商品待生效的定时调价
+func attachPricePlan(ctx context.Context, resp *proto.GoodsDetail) error {
+	plan, err := cache.GetPricePlan(ctx, resp.GetGoodsId())
+	if err != nil {
+		return err
+	}
+	for _, p := range plan.Prices {
+		resp.PricePlan = append(resp.PricePlan, toPriceChangeInfo(p))
+	}
+	return nil
+}
+
+func hasSku(skus []*model.GoodsSku, skuId int64) bool {
+	for _, sku := range skus {
+		if sku.SkuId == skuId {
+			return true
+		}
+	}
+	return false
+}
+
+func toPriceChangeInfo(data *model.GoodsPrice) *proto.PriceChangeInfo {
+	return &proto.PriceChangeInfo{
+		Id:              int64(data.ID),
+		GoodsId:         data.GoodsId,
+		SkuId:           data.SkuId,
+		PriceCent:       data.Price,
+		MarketPriceCent: data.MarketPrice,
+		EffectiveAt:     data.EffectiveAt.Unix(),
+		Status:          int32(data.Status),
+		Operator:        data.CreateBy,
+	}
+}
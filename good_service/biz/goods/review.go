@@ -0,0 +1,173 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good_service/dao/blob"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"good_service/third_party/snowflake"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+var (
+	ErrReviewNotFound = errors.New("评价不存在")
+	ErrReviewExists   = errors.New("该订单商品已评价")
+)
+
+const (
+	maxReviewContent  = 500
+	maxReviewImageIds = 9
+)
+
+// CreateReview 创建评价，待审核后计入商品评分
+// 调用方需要先校验用户有该商品已完成的订单行
+func CreateReview(ctx context.Context, req *proto.CreateReviewReq) (*proto.ReviewInfo, error) {
+	if req.GetRating() < 1 || req.GetRating() > 5 {
+		return nil, &InvalidGoodsError{"Rating", "需在1-5之间"}
+	}
+	if utf8.RuneCountInString(req.GetContent()) > maxReviewContent {
+		return nil, &InvalidGoodsError{"Content", fmt.Sprintf("长度不能超过%d", maxReviewContent)}
+	}
+	imageIds, err := encodeMediaIds("ImageIds", req.GetImageIds(), maxReviewImageIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMediaKind(ctx, "ImageIds", req.GetImageIds(), model.MediaImage); err != nil {
+		return nil, err
+	}
+	if _, err := cache.GetGoods(ctx, req.GetGoodsId()); err != nil {
+		if errors.Is(err, mysql.ErrGoodsNotFound) {
+			return nil, ErrGoodsNotFound
+		}
+		return nil, err
+	}
+
+	operator := strconv.FormatInt(req.GetUserId(), 10)
+	data := &model.GoodsReview{
+		ReviewId: snowflake.GenID(),
+		OrderId:  req.GetOrderId(),
+		GoodsId:  req.GetGoodsId(),
+		SkuId:    req.GetSkuId(),
+		UserId:   req.GetUserId(),
+		Rating:   int8(req.GetRating()),
+		Content:  req.GetContent(),
+		ImageIds: imageIds,
+		Status:   model.ReviewPending,
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	err = mysql.CreateReview(ctx, data)
+	if errors.Is(err, mysql.ErrReviewExists) {
+		return nil, ErrReviewExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReviewInfo(data), nil
+}
+
+// ModerateReview 审核评价，通过和撤销通过时更新商品评分
+func ModerateReview(ctx context.Context, req *proto.ModerateReviewReq, operator string) (*proto.ReviewInfo, error) {
+	st := int8(req.GetStatus())
+	if st != model.ReviewApproved && st != model.ReviewRejected {
+		return nil, &InvalidGoodsError{"Status", "只能为1或2"}
+	}
+	data, err := mysql.ModerateReview(ctx, req.GetReviewId(), st, operator)
+	if errors.Is(err, mysql.ErrReviewNotFound) {
+		return nil, ErrReviewNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache.DelGoods(ctx, data.GoodsId)
+	return toReviewInfo(data), nil
+}
+
+// ListReviews 分页查询评价，不传Status时只返回审核通过的
+func ListReviews(ctx context.Context, req *proto.ListReviewsReq) (*proto.ListReviewsResp, error) {
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	st := model.ReviewApproved
+	if req.Status != nil {
+		st = int8(req.GetStatus())
+	}
+	list, total, err := mysql.ListReviews(ctx, req.GetGoodsId(), st, (pageNum-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.ReviewInfo, 0, len(list))
+	for _, review := range list {
+		data = append(data, toReviewInfo(review))
+	}
+	return &proto.ListReviewsResp{Total: total, Data: data}, nil
+}
+
+// checkMediaKind 校验媒体都已上传且类型正确
+func checkMediaKind(ctx context.Context, field string, idList []int64, kind int8) error {
+	if len(idList) == 0 {
+		return nil
+	}
+	list, err := mysql.GetMediaByIds(ctx, idList)
+	if err != nil {
+		return err
+	}
+	kinds := make(map[int64]int8, len(list))
+	for _, media := range list {
+		kinds[media.MediaId] = media.Kind
+	}
+	for _, id := range idList {
+		if kinds[id] != kind {
+			return &InvalidGoodsError{field, fmt.Sprintf("%d不是已上传的%s", id, mediaKindName(kind))}
+		}
+	}
+	return nil
+}
+
+func mediaKindName(kind int8) string {
+	if kind == model.MediaVideo {
+		return "视频"
+	}
+	return "图片"
+}
+
+// goodsRating 平均评分，保留一位小数
+func goodsRating(data *model.Goods) float32 {
+	if data.ReviewCount <= 0 {
+		return 0
+	}
+	return float32(math.Round(float64(data.RatingSum)*10/float64(data.ReviewCount)) / 10)
+}
+
+func toReviewInfo(data *model.GoodsReview) *proto.ReviewInfo {
+	idList := decodeMediaIds(data.ImageIds)
+	images := make([]string, 0, len(idList))
+	thumbs := make([]string, 0, len(idList))
+	for _, id := range idList {
+		images = append(images, blob.URL(mediaObjectKey(id)))
+		thumbs = append(thumbs, blob.URL(mediaThumbKey(id)))
+	}
+	return &proto.ReviewInfo{
+		ReviewId:    data.ReviewId,
+		OrderId:     data.OrderId,
+		GoodsId:     data.GoodsId,
+		SkuId:       data.SkuId,
+		UserId:      data.UserId,
+		Rating:      int32(data.Rating),
+		Content:     data.Content,
+		Images:      images,
+		ImageThumbs: thumbs,
+		Status:      int32(data.Status),
+		CreateAt:    data.CreateAt.Unix(),
+	}
+}
@@ -0,0 +1,157 @@
+package goods
+
+import (
+	"context"
+	"good_service/dao/cache"
+	"good_service/dao/redis"
+	"good_service/model"
+	"good_service/proto"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 每个观众缓存的事件数，消费不过来时丢弃最旧的事件，事件都带完整状态，丢弃不影响最终结果
+const watcherBuffer = 16
+
+// roomHub 把redis订阅到的直播间事件分发给本实例上的观众
+type roomHub struct {
+	mu       sync.Mutex
+	sub      *redis.RoomEventSub
+	watchers map[int64]map[chan *proto.RoomEvent]struct{}
+}
+
+var hub *roomHub
+
+// InitRoomHub 建立本实例的直播间事件订阅
+func InitRoomHub(ctx context.Context) {
+	hub = &roomHub{
+		sub:      redis.NewRoomEventSub(ctx),
+		watchers: make(map[int64]map[chan *proto.RoomEvent]struct{}),
+	}
+	go hub.dispatch()
+}
+
+func (h *roomHub) dispatch() {
+	for event := range h.sub.Channel() {
+		msg := toRoomEvent(event)
+		h.mu.Lock()
+		for ch := range h.watchers[event.RoomId] {
+			select {
+			case ch <- msg:
+			default:
+				// 缓冲满了丢弃最旧的一条再放入
+				select {
+				case <-ch:
+				default:
+				}
+				select {
+				case ch <- msg:
+				default:
+				}
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// WatchRoom 订阅直播间商品变化，返回的函数用于取消订阅
+func WatchRoom(ctx context.Context, roomId int64) (<-chan *proto.RoomEvent, func(), error) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	watchers, ok := hub.watchers[roomId]
+	if !ok {
+		// 本实例第一个观众才去订阅这个直播间的频道
+		if err := hub.sub.Subscribe(ctx, roomId); err != nil {
+			return nil, nil, err
+		}
+		watchers = make(map[chan *proto.RoomEvent]struct{})
+		hub.watchers[roomId] = watchers
+	}
+	ch := make(chan *proto.RoomEvent, watcherBuffer)
+	watchers[ch] = struct{}{}
+
+	cancel := func() {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		delete(watchers, ch)
+		if len(watchers) > 0 {
+			return
+		}
+		delete(hub.watchers, roomId)
+		if err := hub.sub.Unsubscribe(context.Background(), roomId); err != nil {
+			zap.L().Error("redis.Unsubscribe failed", zap.Int64("room_id", roomId), zap.Error(err))
+		}
+	}
+	return ch, cancel, nil
+}
+
+// RoomSnapshot 直播间当前的商品排序和讲解商品
+func RoomSnapshot(ctx context.Context, roomId int64) (*proto.RoomEvent, error) {
+	event, err := roomEvent(ctx, roomId, model.RoomEventSnapshot, nil)
+	if err != nil {
+		return nil, err
+	}
+	return toRoomEvent(event), nil
+}
+
+func roomEvent(ctx context.Context, roomId int64, eventType int32, changed []int64) (*model.RoomEvent, error) {
+	list, err := cache.GetRoomGoods(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+	var current int64
+	idList := make([]int64, 0, len(list))
+	for _, rg := range list {
+		idList = append(idList, rg.GoodsId)
+		if rg.IsCurrent == 1 {
+			current = rg.GoodsId
+		}
+	}
+	// 与直播间商品列表一致，只包含在架的商品
+	goodsList, err := onShelfGoods(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	event := &model.RoomEvent{
+		RoomId:     roomId,
+		Type:       eventType,
+		GoodsIds:   make([]int64, 0, len(goodsList)),
+		ChangedIds: changed,
+		Timestamp:  time.Now().UnixMilli(),
+	}
+	for _, goods := range goodsList {
+		event.GoodsIds = append(event.GoodsIds, goods.GoodsId)
+	}
+	if containsGoods(goodsList, current) {
+		event.CurrentGoodsId = current
+	}
+	return event, nil
+}
+
+// publishRoomEvent 直播间商品修改成功后清除缓存并广播事件，广播失败只记录日志，不影响本次修改
+func publishRoomEvent(ctx context.Context, roomId int64, eventType int32, changed []int64) {
+	// 先清缓存，观众收到事件后拉取的是最新列表
+	cache.DelRoomGoods(ctx, roomId)
+	event, err := roomEvent(ctx, roomId, eventType, changed)
+	if err != nil {
+		zap.L().Error("goods.roomEvent failed", zap.Int64("room_id", roomId), zap.Error(err))
+		return
+	}
+	if err := redis.PublishRoomEvent(ctx, event); err != nil {
+		zap.L().Error("redis.PublishRoomEvent failed", zap.Int64("room_id", roomId), zap.Error(err))
+	}
+}
+
+func toRoomEvent(event *model.RoomEvent) *proto.RoomEvent {
+	return &proto.RoomEvent{
+		RoomId:         event.RoomId,
+		Type:           event.Type,
+		CurrentGoodsId: event.CurrentGoodsId,
+		GoodsIds:       event.GoodsIds,
+		ChangedIds:     event.ChangedIds,
+		Timestamp:      event.Timestamp,
+	}
+}
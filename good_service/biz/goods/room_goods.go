@@ -0,0 +1,76 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+)
+
+// AddRoomGoods 直播间挂载商品，商品必须存在
+func AddRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	goodsList, err := mysql.GetGoodsById(ctx, idList)
+	if err != nil {
+		return err
+	}
+	if len(goodsList) != len(uniqueIds(idList)) {
+		return ErrGoodsNotFound
+	}
+	if err := mysql.AddRoomGoods(ctx, roomId, idList, operator); err != nil {
+		return err
+	}
+	// 重新挂载的商品保留之前的销量，重建排行
+	cache.DelRoomSales(ctx, roomId)
+	publishRoomEvent(ctx, roomId, model.RoomEventAdd, idList)
+	return nil
+}
+
+// RemoveRoomGoods 直播间移除商品
+func RemoveRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	if err := mysql.RemoveRoomGoods(ctx, roomId, idList, operator); err != nil {
+		return err
+	}
+	cache.DelRoomSales(ctx, roomId)
+	publishRoomEvent(ctx, roomId, model.RoomEventRemove, idList)
+	return nil
+}
+
+// SortRoomGoods 直播间商品排序
+func SortRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	err := mysql.SortRoomGoods(ctx, roomId, idList, operator)
+	if errors.Is(err, mysql.ErrRoomGoodsNotFound) {
+		return ErrGoodsNotInRoom
+	}
+	if err != nil {
+		return err
+	}
+	publishRoomEvent(ctx, roomId, model.RoomEventSort, idList)
+	return nil
+}
+
+// SetCurrentGoods 切换直播间当前讲解商品
+func SetCurrentGoods(ctx context.Context, roomId, goodsId int64, operator string) error {
+	err := mysql.SetCurrentGoods(ctx, roomId, goodsId, operator)
+	if errors.Is(err, mysql.ErrRoomGoodsNotFound) {
+		return ErrGoodsNotInRoom
+	}
+	if err != nil {
+		return err
+	}
+	publishRoomEvent(ctx, roomId, model.RoomEventCurrent, []int64{goodsId})
+	return nil
+}
+
+func uniqueIds(idList []int64) []int64 {
+	seen := make(map[int64]struct{}, len(idList))
+	res := make([]int64, 0, len(idList))
+	for _, id := range idList {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+package goods
+
+import (
+	"context"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 直播间商品列表的排序方式
+const (
+	RoomSortWeight int32 = 0
+	RoomSortSales  int32 = 1
+)
+
+const (
+	defaultRankLimit = 10
+	maxRankLimit     = 50
+)
+
+// AddSales 订单支付后累计商品销量和直播间销量，重复的订单消息直接忽略
+func AddSales(ctx context.Context, info *model.OrderPaidInfo) error {
+	applied, err := mysql.AddSales(ctx, info)
+	if err != nil || !applied {
+		return err
+	}
+	idList := make([]int64, 0, len(info.Lines))
+	for _, line := range info.Lines {
+		idList = append(idList, line.GoodsId)
+		if line.RoomId <= 0 {
+			continue
+		}
+		if err := cache.IncrRoomSales(ctx, line.RoomId, line.GoodsId, line.Num); err != nil {
+			// 排行和MySQL不一致了，删除后下次查询时重建
+			zap.L().Error("cache.IncrRoomSales failed", zap.Int64("room_id", line.RoomId), zap.Int64("goods_id", line.GoodsId), zap.Error(err))
+			cache.DelRoomSales(ctx, line.RoomId)
+		}
+	}
+	// 商品详情和搜索索引中的销量随缓存失效更新
+	cache.DelGoods(ctx, uniqueIds(idList)...)
+	return nil
+}
+
+// GetRoomSalesRank 直播间实时销量排行，已下架的商品不返回
+func GetRoomSalesRank(ctx context.Context, roomId int64, limit int) (*proto.GoodsListResp, error) {
+	if limit <= 0 || limit > maxRankLimit {
+		limit = defaultRankLimit
+	}
+	rank, err := cache.GetRoomSalesRank(ctx, roomId, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	idList := make([]int64, 0, len(rank))
+	for _, rs := range rank {
+		idList = append(idList, rs.GoodsId)
+	}
+	goodsList, err := onShelfGoods(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	flashSale, err := getFlashSaleMap(ctx, roomId, idList, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	goodsMap := make(map[int64]*model.Goods, len(goodsList))
+	for _, goods := range goodsList {
+		goodsMap[goods.GoodsId] = goods
+	}
+	data := make([]*proto.GoodsInfo, 0, len(rank))
+	for _, rs := range rank {
+		goods, ok := goodsMap[rs.GoodsId]
+		if !ok {
+			continue
+		}
+		info := toGoodsInfo(goods, flashSale[goods.GoodsId])
+		info.RoomSales = rs.Sales
+		data = append(data, info)
+	}
+	return &proto.GoodsListResp{Data: data}, nil
+}
+
+// sortBySales 按直播间销量倒序排列商品列表，销量相同的保持原有的权重顺序
+func sortBySales(ctx context.Context, roomId int64, resp *proto.GoodsListResp) error {
+	rank, err := cache.GetRoomSalesRank(ctx, roomId, 0)
+	if err != nil {
+		return err
+	}
+	sales := make(map[int64]int64, len(rank))
+	for _, rs := range rank {
+		sales[rs.GoodsId] = rs.Sales
+	}
+	for _, info := range resp.Data {
+		info.RoomSales = sales[info.GoodsId]
+	}
+	sort.SliceStable(resp.Data, func(i, j int) bool {
+		return resp.Data[i].RoomSales > resp.Data[j].RoomSales
+	})
+	return nil
+}
@@ -0,0 +1,204 @@
+package goods
+
+import (
+	"context"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/dao/search"
+	"good_service/model"
+	"good_service/proto"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 重建索引时每批查询的商品数
+const rebuildBatchSize = 500
+
+// indexer 将商品变化同步到搜索索引
+// 本实例和其他实例的商品缓存失效通知都会触发重建对应商品的索引
+type indexer struct {
+	mu      sync.Mutex
+	pending map[int64]struct{}
+	signal  chan struct{}
+}
+
+var idx *indexer
+
+// InitSearch 初始化商品搜索索引，从MySQL全量重建后再处理商品的增量变化
+func InitSearch(ctx context.Context) error {
+	if err := search.Init(); err != nil {
+		return err
+	}
+	idx = &indexer{
+		pending: make(map[int64]struct{}),
+		signal:  make(chan struct{}, 1),
+	}
+	// 先注册回调，重建期间的变化在重建完成后补上
+	cache.OnInvalidate(func(inv *cache.Invalidation) {
+		idx.add(inv.GoodsIds)
+	})
+	go idx.run(ctx)
+	return nil
+}
+
+func (i *indexer) add(idList []int64) {
+	if len(idList) == 0 {
+		return
+	}
+	i.mu.Lock()
+	for _, id := range idList {
+		i.pending[id] = struct{}{}
+	}
+	i.mu.Unlock()
+	select {
+	case i.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (i *indexer) run(ctx context.Context) {
+	start := time.Now()
+	total, err := rebuildIndex(ctx)
+	if err != nil {
+		zap.L().Error("goods.rebuildIndex failed", zap.Int("indexed", total), zap.Error(err))
+	} else {
+		zap.L().Info("goods search index rebuilt", zap.Int("total", total), zap.Duration("cost", time.Since(start)))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.signal:
+		}
+		i.mu.Lock()
+		pending := i.pending
+		i.pending = make(map[int64]struct{})
+		i.mu.Unlock()
+		for id := range pending {
+			if err := reindexGoods(ctx, id); err != nil {
+				zap.L().Error("goods.reindexGoods failed", zap.Int64("goods_id", id), zap.Error(err))
+			}
+		}
+	}
+}
+
+// rebuildIndex 分批遍历全部商品写入索引
+func rebuildIndex(ctx context.Context) (int, error) {
+	paths, err := categoryPaths(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var lastId int64
+	total := 0
+	for {
+		list, err := mysql.GetGoodsAfter(ctx, lastId, rebuildBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(list) == 0 {
+			return total, nil
+		}
+		docs := make(map[int64]*search.GoodsDoc, len(list))
+		for _, data := range list {
+			docs[data.GoodsId] = toGoodsDoc(data, paths[data.CategoryId])
+		}
+		if err := search.BatchIndex(docs); err != nil {
+			return total, err
+		}
+		total += len(list)
+		lastId = list[len(list)-1].GoodsId
+	}
+}
+
+// reindexGoods 重建单个商品的索引，商品已删除时删除索引
+func reindexGoods(ctx context.Context, goodsId int64) error {
+	data, err := mysql.GetGoodsByGoodsId(ctx, goodsId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return search.Delete(goodsId)
+	}
+	if err != nil {
+		return err
+	}
+	var path string
+	c, err := mysql.GetCategory(ctx, data.CategoryId)
+	if err == nil {
+		path = c.Path
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return search.Index(goodsId, toGoodsDoc(data, path))
+}
+
+// categoryPaths 查询所有类目的路径
+func categoryPaths(ctx context.Context) (map[int64]string, error) {
+	list, err := mysql.GetAllCategory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[int64]string, len(list))
+	for _, c := range list {
+		paths[int64(c.ID)] = c.Path
+	}
+	return paths, nil
+}
+
+func toGoodsDoc(data *model.Goods, path string) *search.GoodsDoc {
+	return &search.GoodsDoc{
+		Title:        data.Title,
+		Brief:        data.Brief,
+		BrandName:    data.BrandName,
+		CategoryPath: strings.Split(strings.Trim(path, "/"), "/"),
+		Price:        float64(data.Price),
+		Status:       float64(data.Status),
+		Sales:        float64(data.Sales),
+	}
+}
+
+// SearchGoods 按关键词搜索商品，支持类目、价格区间和状态过滤
+func SearchGoods(ctx context.Context, req *proto.SearchGoodsReq) (*proto.ListGoodsResp, error) {
+	if req.GetMinPriceCent() < 0 || req.GetMaxPriceCent() < 0 ||
+		(req.GetMaxPriceCent() > 0 && req.GetMinPriceCent() > req.GetMaxPriceCent()) {
+		return nil, &InvalidGoodsError{"Price", "区间有误"}
+	}
+	if req.GetSortBy() < search.SortScore || req.GetSortBy() > search.SortSalesDesc {
+		return nil, &InvalidGoodsError{"SortBy", "有误"}
+	}
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	idList, total, err := search.Search(&search.Query{
+		Keyword:    strings.TrimSpace(req.GetKeyword()),
+		CategoryId: req.GetCategoryId(),
+		MinPrice:   req.GetMinPriceCent(),
+		MaxPrice:   req.GetMaxPriceCent(),
+		Status:     req.Status,
+		SortBy:     int(req.GetSortBy()),
+		Offset:     (pageNum - 1) * pageSize,
+		Limit:      pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 索引只用于检索，返回的商品信息以缓存和MySQL为准
+	list, err := cache.GetGoodsList(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*proto.GoodsDetail, 0, len(list))
+	for _, goods := range list {
+		data = append(data, toGoodsDetail(goods))
+	}
+	fillAttrTypes(ctx, data...)
+	return &proto.ListGoodsResp{Total: total, Data: data}, nil
+}
@@ -0,0 +1,122 @@
+package goods
+
+import (
+	"context"
+	"good_service/config"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 每次扫描处理的到期商品数
+const shelfBatchSize = 200
+
+// StartScheduler 定时扫描到期的上下架时间和定时调价，修改商品状态和价格
+func StartScheduler(ctx context.Context, cfg *config.ScheduleConfig) {
+	interval := 10 * time.Second
+	if cfg != nil && cfg.Interval > 0 {
+		interval = time.Duration(cfg.Interval) * time.Second
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := applyShelfSchedule(ctx, now); err != nil {
+					zap.L().Error("goods.applyShelfSchedule failed", zap.Error(err))
+				}
+				if err := applyPriceSchedule(ctx, now); err != nil {
+					zap.L().Error("goods.applyPriceSchedule failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// applyShelfSchedule 处理一批到期的商品，上下架时间都已到期时以较晚的为准
+func applyShelfSchedule(ctx context.Context, now time.Time) error {
+	list, err := mysql.GetDueShelfGoods(ctx, now, shelfBatchSize)
+	if err != nil {
+		return err
+	}
+	changed := make([]int64, 0, len(list))
+	for _, data := range list {
+		onDue := data.OnShelfAt != nil && !data.OnShelfAt.After(now)
+		offDue := data.OffShelfAt != nil && !data.OffShelfAt.After(now)
+		var status int8 = 1
+		if onDue && (!offDue || data.OnShelfAt.After(*data.OffShelfAt)) {
+			status = 0
+		}
+		ok, err := mysql.SetShelfStatus(ctx, data, status, onDue, offDue)
+		if err != nil {
+			zap.L().Error("mysql.SetShelfStatus failed", zap.Int64("goods_id", data.GoodsId), zap.Error(err))
+			continue
+		}
+		// 已被其他实例处理或被人工修改
+		if !ok {
+			continue
+		}
+		zap.L().Info("goods shelf status changed", zap.Int64("goods_id", data.GoodsId), zap.Int8("status", status))
+		changed = append(changed, data.GoodsId)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	// 清除商品缓存，并通知挂载了这些商品的直播间
+	cache.DelGoods(ctx, changed...)
+	roomIds, err := mysql.GetRoomIdsByGoodsIds(ctx, changed)
+	if err != nil {
+		return err
+	}
+	for _, roomId := range roomIds {
+		publishRoomEvent(ctx, roomId, model.RoomEventShelf, changed)
+	}
+	return nil
+}
+
+// onShelfGoods 按传入顺序查询商品，只返回在架的商品
+func onShelfGoods(ctx context.Context, idList []int64) ([]*model.Goods, error) {
+	list, err := cache.GetGoodsList(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*model.Goods, 0, len(list))
+	for _, goods := range list {
+		if goods.Status == 0 {
+			data = append(data, goods)
+		}
+	}
+	return data, nil
+}
+
+func containsGoods(list []*model.Goods, goodsId int64) bool {
+	for _, goods := range list {
+		if goods.GoodsId == goodsId {
+			return true
+		}
+	}
+	return false
+}
+
+// unixTime 将unix秒转换为时间，0表示未设置
+func unixTime(sec int64) *time.Time {
+	if sec <= 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+func unixSecond(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,267 @@
+package goods
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"good_service/dao/cache"
+	"good_service/dao/mysql"
+	"good_service/model"
+	"good_service/proto"
+	"good_service/rpc"
+	"good_service/third_party/snowflake"
+	"money"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	pb "google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// SetGoodsSku 整体设置商品的规格项和规格，规格项为空时清除商品的所有规格
+func SetGoodsSku(ctx context.Context, req *proto.SetGoodsSkuReq, operator string) (*proto.GoodsDetail, error) {
+	_, err := mysql.GetGoodsByGoodsId(ctx, req.GetGoodsId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	old, err := mysql.GetSkusByGoodsId(ctx, req.GetGoodsId())
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := buildSpecs(req.GetGoodsId(), req.GetSpecs(), operator)
+	if err != nil {
+		return nil, err
+	}
+	skus, err := buildSkus(req.GetGoodsId(), req.GetSpecs(), req.GetSkus(), old, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := skuPriceRecords(old, skus, operator, time.Now())
+	err = mysql.SaveGoodsSku(ctx, req.GetGoodsId(), specs, skus, prices, operator)
+	if errors.Is(err, mysql.ErrGoodsNotFound) {
+		return nil, ErrGoodsNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache.DelGoods(ctx, req.GetGoodsId())
+	return GetGoodsDetail(ctx, req.GetGoodsId(), 0)
+}
+
+// skuPriceRecords 新增或价格有变化的规格生成价格变更记录
+func skuPriceRecords(old, skus []*model.GoodsSku, operator string, now time.Time) []*model.GoodsPrice {
+	oldMap := make(map[int64]*model.GoodsSku, len(old))
+	for _, sku := range old {
+		oldMap[sku.SkuId] = sku
+	}
+	var data []*model.GoodsPrice
+	for _, sku := range skus {
+		if o, ok := oldMap[sku.SkuId]; ok && o.Price == sku.Price && o.MarketPrice == sku.MarketPrice {
+			continue
+		}
+		p := &model.GoodsPrice{
+			GoodsId:     sku.GoodsId,
+			SkuId:       sku.SkuId,
+			Price:       sku.Price,
+			MarketPrice: sku.MarketPrice,
+			EffectiveAt: now,
+			Status:      model.PriceApplied,
+		}
+		p.CreateBy = operator
+		p.UpdateBy = operator
+		data = append(data, p)
+	}
+	return data
+}
+
+// buildSpecs 校验规格项，规格项名和取值都不能重复
+func buildSpecs(goodsId int64, list []*proto.SpecInfo, operator string) ([]*model.GoodsSpec, error) {
+	names := make(map[string]struct{}, len(list))
+	specs := make([]*model.GoodsSpec, 0, len(list))
+	for i, spec := range list {
+		if utf8.RuneCountInString(spec.GetName()) == 0 || utf8.RuneCountInString(spec.GetName()) > 64 {
+			return nil, &InvalidGoodsError{"Specs", "规格名长度需在1-64之间"}
+		}
+		if _, ok := names[spec.GetName()]; ok {
+			return nil, &InvalidGoodsError{"Specs", "规格名不能重复"}
+		}
+		names[spec.GetName()] = struct{}{}
+		if len(spec.GetValues()) == 0 {
+			return nil, &InvalidGoodsError{"Specs", "规格至少需要一个取值"}
+		}
+		values := make(map[string]struct{}, len(spec.GetValues()))
+		for _, v := range spec.GetValues() {
+			if len(v) == 0 {
+				return nil, &InvalidGoodsError{"Specs", "规格取值不能为空"}
+			}
+			if _, ok := values[v]; ok {
+				return nil, &InvalidGoodsError{"Specs", "规格取值不能重复"}
+			}
+			values[v] = struct{}{}
+		}
+		b, _ := json.Marshal(spec.GetValues())
+		if len(b) > 1024 {
+			return nil, &InvalidGoodsError{"Specs", "规格取值总长度不能超过1024"}
+		}
+		data := &model.GoodsSpec{
+			GoodsId:    goodsId,
+			Name:       spec.GetName(),
+			SpecValues: string(b),
+			Sort:       i,
+		}
+		data.CreateBy = operator
+		data.UpdateBy = operator
+		specs = append(specs, data)
+	}
+	return specs, nil
+}
+
+// buildSkus 校验规格，每个规格必须覆盖所有规格项且组合不能重复，SkuId为0的规格新建
+func buildSkus(goodsId int64, specs []*proto.SpecInfo, list []*proto.SkuInfo, old []*model.GoodsSku, operator string) ([]*model.GoodsSku, error) {
+	if len(specs) == 0 && len(list) > 0 {
+		return nil, &InvalidGoodsError{"Skus", "没有规格项时不能设置规格"}
+	}
+	if len(specs) > 0 && len(list) == 0 {
+		return nil, &InvalidGoodsError{"Skus", "至少需要一个规格"}
+	}
+	exist := make(map[int64]struct{}, len(old))
+	for _, sku := range old {
+		exist[sku.SkuId] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(list))
+	skus := make([]*model.GoodsSku, 0, len(list))
+	for _, sku := range list {
+		if len(sku.GetSpecs()) != len(specs) {
+			return nil, &InvalidGoodsError{"Skus", "规格项不完整"}
+		}
+		for _, spec := range specs {
+			v, ok := sku.GetSpecs()[spec.GetName()]
+			if !ok || !contains(spec.GetValues(), v) {
+				return nil, &InvalidGoodsError{"Skus", "规格取值不在规格项中"}
+			}
+		}
+		text := specText(specs, sku.GetSpecs())
+		if _, ok := seen[text]; ok {
+			return nil, &InvalidGoodsError{"Skus", "规格组合不能重复"}
+		}
+		seen[text] = struct{}{}
+
+		if sku.GetPriceCent() <= 0 || sku.GetMarketPriceCent() < 0 {
+			return nil, &InvalidGoodsError{"Skus", "价格有误"}
+		}
+		if len(sku.GetCode()) > 64 {
+			return nil, &InvalidGoodsError{"Skus", "编码长度不能超过64"}
+		}
+		if len(sku.GetImage()) > 0 {
+			u, err := url.Parse(sku.GetImage())
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 || len(sku.GetImage()) > 255 {
+				return nil, &InvalidGoodsError{"Skus", "图片必须是http(s)地址"}
+			}
+		}
+		if sku.GetStatus() != 0 && sku.GetStatus() != 1 {
+			return nil, &InvalidGoodsError{"Skus", "状态只能为0或1"}
+		}
+
+		data := &model.GoodsSku{
+			SkuId:       sku.GetSkuId(),
+			GoodsId:     goodsId,
+			Code:        sku.GetCode(),
+			Image:       sku.GetImage(),
+			MarketPrice: sku.GetMarketPriceCent(),
+			Price:       sku.GetPriceCent(),
+			Status:      int8(sku.GetStatus()),
+		}
+		if data.SkuId == 0 {
+			data.SkuId = snowflake.GenID()
+		} else if _, ok := exist[data.SkuId]; !ok {
+			return nil, &InvalidGoodsError{"Skus", "规格不存在"}
+		}
+		// map序列化时按key排序，相同的规格组合序列化结果一致
+		b, _ := json.Marshal(sku.GetSpecs())
+		data.Specs = string(b)
+		data.CreateBy = operator
+		data.UpdateBy = operator
+		skus = append(skus, data)
+	}
+	return skus, nil
+}
+
+// attachSku 给商品详情带上规格项和每个规格的可用库存
+func attachSku(ctx context.Context, resp *proto.GoodsDetail) error {
+	data, err := cache.GetGoodsSku(ctx, resp.GetGoodsId())
+	if err != nil {
+		return err
+	}
+	if len(data.Skus) == 0 {
+		return nil
+	}
+
+	specs := make([]*proto.SpecInfo, 0, len(data.Specs))
+	for _, spec := range data.Specs {
+		specs = append(specs, &proto.SpecInfo{
+			Name:   spec.Name,
+			Values: decodeList(spec.SpecValues),
+		})
+	}
+	skus := make([]*proto.SkuInfo, 0, len(data.Skus))
+	storeReq := make([]*proto.GoodsStoreInfo, 0, len(data.Skus))
+	for _, sku := range data.Skus {
+		var values map[string]string
+		if err := json.Unmarshal([]byte(sku.Specs), &values); err != nil {
+			zap.L().Error("decode sku specs failed", zap.Int64("sku_id", sku.SkuId), zap.Error(err))
+		}
+		skus = append(skus, &proto.SkuInfo{
+			SkuId:           sku.SkuId,
+			Specs:           values,
+			SpecText:        specText(specs, values),
+			Price:           money.FromCent(sku.Price).String(),
+			PriceCent:       sku.Price,
+			MarketPriceCent: sku.MarketPrice,
+			Code:            sku.Code,
+			Image:           sku.Image,
+			Status:          int32(sku.Status),
+		})
+		storeReq = append(storeReq, &proto.GoodsStoreInfo{GoodsId: sku.GoodsId, SkuId: sku.SkuId})
+	}
+
+	// 库存服务不可用时返回不带库存的详情，不影响浏览商品
+	stores, err := rpc.StoreCli.BatchGetStore(ctx, &proto.GoodsListStore{Data: storeReq})
+	if err != nil {
+		zap.L().Error("rpc.StoreCli.BatchGetStore failed", zap.Int64("goods_id", resp.GetGoodsId()), zap.Error(err))
+	}
+	for i, s := range stores.GetData() {
+		if i < len(skus) {
+			skus[i].Stock = pb.Int64(s.GetNum())
+		}
+	}
+	resp.Specs = specs
+	resp.Skus = skus
+	return nil
+}
+
+// specText 按规格项顺序拼接规格取值
+func specText(specs []*proto.SpecInfo, values map[string]string) string {
+	list := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		list = append(list, values[spec.GetName()])
+	}
+	return strings.Join(list, " ")
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
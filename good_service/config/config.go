@@ -17,13 +17,28 @@ type SrvConfig struct {
 	StartTime string `mapstructure:"start_time"`
 	MachineId int    `mapstructure:"machine_id"`
 
-	Ip       string `mapstructure:"ip"`
-	RpcPort  int    `mapstructure:"rpcPort"`
-	HttpPort int    `mapstructure:"httpPort"`
+	Ip        string `mapstructure:"ip"`
+	RpcPort   int    `mapstructure:"rpcPort"`
+	HttpPort  int    `mapstructure:"httpPort"`
+	AdminPort int    `mapstructure:"adminPort"` // 运行指标端口，只监听127.0.0.1，为0时不启动
 
 	*MySQLConfig  `mapstructure:"mysql"`
 	*LogConfig    `mapstructure:"log"`
 	*ConsulConfig `mapstructure:"consul"`
+	*RedisConfig  `mapstructure:"redis"`
+
+	*LocalCacheConfig `mapstructure:"local_cache"`
+	*StoreService     `mapstructure:"store_service"`
+	*ScheduleConfig   `mapstructure:"schedule"`
+	*MediaConfig      `mapstructure:"media"`
+	*RocketMqConfig   `mapstructure:"rocketmq"`
+	*AuthConfig       `mapstructure:"auth"`
+}
+
+// AuthConfig 登录令牌，Secret与用户服务一致；ServiceSecret用于服务间调用，用户服务没有这个密钥
+type AuthConfig struct {
+	Secret        string `mapstructure:"secret"`         // 通过环境变量AUTH_SECRET设置
+	ServiceSecret string `mapstructure:"service_secret"` // 通过环境变量SERVICE_AUTH_SECRET设置
 }
 
 type MySQLConfig struct {
@@ -44,13 +59,76 @@ type LogConfig struct {
 	MaxBackups int    `mapstructure:"max_backups"`
 }
 
+type RedisConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Password string `mapstructure:"password"`
+	Db       int    `mapstructure:"db"`
+	PoolSize int    `mapstructure:"pool_size"`
+}
+
+// LocalCacheConfig 本地缓存的容量和过期时间(秒)
+type LocalCacheConfig struct {
+	ListSize   int `mapstructure:"list_size"`
+	DetailSize int `mapstructure:"detail_size"`
+	Expire     int `mapstructure:"expire"`
+}
+
+// ScheduleConfig 定时上下架和定时调价的扫描间隔(秒)
+type ScheduleConfig struct {
+	Interval int `mapstructure:"interval"`
+}
+
+// MediaConfig 商品图片和视频的存储，backend为local时存本地目录并由网关提供访问，为s3时存S3兼容的对象存储
+// 大小限制单位为MB，缩略图按最长边缩放到ThumbSize像素
+type MediaConfig struct {
+	Backend      string    `mapstructure:"backend"`
+	Dir          string    `mapstructure:"dir"`
+	BaseURL      string    `mapstructure:"base_url"`
+	MaxImageSize int64     `mapstructure:"max_image_size"`
+	MaxVideoSize int64     `mapstructure:"max_video_size"`
+	ThumbSize    int       `mapstructure:"thumb_size"`
+	S3           *S3Config `mapstructure:"s3"`
+}
+
+type S3Config struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	UseSSL    bool   `mapstructure:"use_ssl"`
+}
+
+type RocketMqConfig struct {
+	Addr    string `mapstructure:"addr"`
+	GroupId string `mapstructure:"group_id"`
+	Topic   struct {
+		OrderPaid string `mapstructure:"order_paid"`
+	}
+}
+
+type StoreService struct {
+	Name string `mapstructure:"name"`
+}
+
 type ConsulConfig struct {
 	Address string `mapstructure:"address"`
 }
 
+// secretEnv 密钥不写入配置文件，从环境变量读取
+var secretEnv = map[string]string{
+	"auth.secret":         "AUTH_SECRET",
+	"auth.service_secret": "SERVICE_AUTH_SECRET",
+}
+
 func Init(filepath string) (err error) {
 	//指定配置文件路径
 	viper.SetConfigFile(filepath)
+	for key, env := range secretEnv {
+		if err = viper.BindEnv(key, env); err != nil {
+			return
+		}
+	}
 
 	//读取配置信息
 	err = viper.ReadInConfig()
@@ -0,0 +1,58 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"good_service/config"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Store 媒体文件的存储后端，开发环境用本地目录，线上用S3兼容的对象存储
+type Store interface {
+	// Put 保存文件，size未知时传-1
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL 文件的访问地址
+	URL(key string) string
+}
+
+var store Store
+
+func Init(cfg *config.MediaConfig) (err error) {
+	switch cfg.Backend {
+	case "local":
+		store, err = newLocalStore(cfg.Dir, cfg.BaseURL)
+	case "s3":
+		if cfg.S3 == nil {
+			return fmt.Errorf("media.s3 config is missing")
+		}
+		store, err = newS3Store(cfg.S3, cfg.BaseURL)
+	default:
+		return fmt.Errorf("unknown media backend %q", cfg.Backend)
+	}
+	if err != nil {
+		return
+	}
+	zap.L().Info("Init media store success!", zap.String("backend", cfg.Backend))
+	return
+}
+
+func Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	return store.Put(ctx, key, r, size, contentType)
+}
+
+func Delete(ctx context.Context, key string) error {
+	return store.Delete(ctx, key)
+}
+
+func URL(key string) string {
+	return store.URL(key)
+}
+
+// joinURL 拼接访问地址
+func joinURL(baseURL, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
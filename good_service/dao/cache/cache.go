@@ -0,0 +1,127 @@
+// Package cache 商品数据的redis读穿透缓存，未命中时回源MySQL并回填
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"time"
+
+	"good_service/dao/redis"
+
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// 缓存过期时间，实际过期时间再加上最多20%的随机值，避免大量key同时过期
+	defaultExpire = 10 * time.Minute
+	// 不存在的数据缓存较短时间，防止用不存在的id穿透到MySQL
+	notFoundExpire = time.Minute
+	// 不存在的数据缓存的占位值
+	notFoundValue = "-"
+	// 合并后的回源请求的超时时间
+	loadTimeout = 3 * time.Second
+)
+
+var errNotFound = errors.New("cache: not found")
+
+// 同一个key的并发回源只放一个请求到MySQL
+var group singleflight.Group
+
+// do 合并同一个key的回源请求，回源使用独立的ctx，不会因为第一个调用方取消而让所有等待者失败
+// 调用方自己的ctx取消时只有它提前返回
+func do(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ch := group.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+		defer cancel()
+		return fn(ctx)
+	})
+	select {
+	case res := <-ch:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func expire(base time.Duration) time.Duration {
+	return base + time.Duration(rand.Int63n(int64(base)/5+1))
+}
+
+// get 读取缓存，ok为false表示未命中，命中占位值时返回errNotFound
+func get(ctx context.Context, key string, v interface{}) (ok bool, err error) {
+	s, err := redis.Rdb.Get(ctx, key).Result()
+	if err == goredis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if s == notFoundValue {
+		return true, errNotFound
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// set 回填缓存，v为nil时写入占位值，失败只记录日志
+func set(ctx context.Context, key string, v interface{}) {
+	value, ttl := notFoundValue, expire(notFoundExpire)
+	if v != nil {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return
+		}
+		value, ttl = string(b), expire(defaultExpire)
+	}
+	if err := redis.Rdb.Set(ctx, key, value, ttl).Err(); err != nil {
+		zap.L().Error("cache.set failed", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// del 删除缓存，数据修改后调用，失败只记录日志，缓存最迟在过期后更新
+func del(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := redis.Rdb.Del(ctx, keys...).Err(); err != nil {
+		zap.L().Error("cache.del failed", zap.Strings("keys", keys), zap.Error(err))
+	}
+}
+
+// load 读穿透，缓存出错时直接回源MySQL，不影响正常查询
+func load[T any](ctx context.Context, key string, fn func(ctx context.Context) (*T, error)) (*T, error) {
+	var data T
+	ok, err := get(ctx, key, &data)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		zap.L().Error("cache.get failed", zap.String("key", key), zap.Error(err))
+	}
+	if ok && err == nil {
+		return &data, nil
+	}
+
+	v, err := do(ctx, key, func(ctx context.Context) (interface{}, error) {
+		res, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			set(ctx, key, nil)
+		} else {
+			set(ctx, key, res)
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*T), nil
+}
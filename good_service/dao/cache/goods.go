@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"good_service/dao/mysql"
+	"good_service/dao/redis"
+	"good_service/model"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func goodsKey(goodsId int64) string {
+	return fmt.Sprintf("xx_goods:%d", goodsId)
+}
+
+func roomGoodsKey(roomId int64) string {
+	return fmt.Sprintf("xx_room_goods:%d", roomId)
+}
+
+func roomFlashSaleKey(roomId int64) string {
+	return fmt.Sprintf("xx_room_flash_sale:%d", roomId)
+}
+
+// GetGoods 根据goods_id查询商品，商品不存在时返回mysql.ErrGoodsNotFound
+func GetGoods(ctx context.Context, goodsId int64) (*model.Goods, error) {
+	data, err := load(ctx, goodsKey(goodsId), func(ctx context.Context) (*model.Goods, error) {
+		data, err := mysql.GetGoodsByGoodsId(ctx, goodsId)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return data, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, mysql.ErrGoodsNotFound
+	}
+	return data, nil
+}
+
+// GetGoodsList 按传入顺序批量查询商品，不存在的商品不返回
+func GetGoodsList(ctx context.Context, idList []int64) ([]*model.Goods, error) {
+	if len(idList) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(idList))
+	for _, id := range idList {
+		keys = append(keys, goodsKey(id))
+	}
+
+	found := make(map[int64]*model.Goods, len(idList))
+	missing := make([]int64, 0, len(idList))
+	values, err := redis.Rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		zap.L().Error("cache.GetGoodsList failed", zap.Error(err))
+		missing = idList
+	} else {
+		for i, v := range values {
+			s, ok := v.(string)
+			if !ok {
+				missing = append(missing, idList[i])
+				continue
+			}
+			if s == notFoundValue {
+				continue
+			}
+			var data model.Goods
+			if err := json.Unmarshal([]byte(s), &data); err != nil {
+				missing = append(missing, idList[i])
+				continue
+			}
+			found[idList[i]] = &data
+		}
+	}
+
+	if len(missing) > 0 {
+		loaded, err := loadGoodsList(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, data := range loaded {
+			found[data.GoodsId] = data
+		}
+	}
+
+	res := make([]*model.Goods, 0, len(found))
+	for _, id := range idList {
+		if data, ok := found[id]; ok {
+			res = append(res, data)
+			// 同一个id只返回一次
+			delete(found, id)
+		}
+	}
+	return res, nil
+}
+
+// loadGoodsList 回源MySQL查询未命中的商品并回填，查不到的id写入占位值
+func loadGoodsList(ctx context.Context, idList []int64) ([]*model.Goods, error) {
+	ids := make([]string, 0, len(idList))
+	for _, id := range idList {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	v, err := do(ctx, "goods:"+strings.Join(ids, ","), func(ctx context.Context) (interface{}, error) {
+		list, err := mysql.GetGoodsById(ctx, idList)
+		if err != nil {
+			return nil, err
+		}
+		exist := make(map[int64]struct{}, len(list))
+		for _, data := range list {
+			exist[data.GoodsId] = struct{}{}
+			set(ctx, goodsKey(data.GoodsId), data)
+		}
+		for _, id := range idList {
+			if _, ok := exist[id]; !ok {
+				set(ctx, goodsKey(id), nil)
+			}
+		}
+		return list, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*model.Goods), nil
+}
+
+// GetRoomGoods 查询直播间挂载的商品，按权重排序
+func GetRoomGoods(ctx context.Context, roomId int64) ([]*model.RoomGoods, error) {
+	data, err := load(ctx, roomGoodsKey(roomId), func(ctx context.Context) (*[]*model.RoomGoods, error) {
+		list, err := mysql.GetGoodsByRoomId(ctx, roomId)
+		if err != nil {
+			return nil, err
+		}
+		return &list, nil
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return *data, nil
+}
+
+// GetRoomFlashSale 查询直播间尚未结束的秒杀场次，按开始时间排序
+// 缓存的是写入时尚未结束的场次，读取时再按当前时间过滤
+func GetRoomFlashSale(ctx context.Context, roomId int64, now time.Time) ([]*model.FlashSale, error) {
+	data, err := load(ctx, roomFlashSaleKey(roomId), func(ctx context.Context) (*[]*model.FlashSale, error) {
+		list, err := mysql.GetRoomFlashSale(ctx, roomId, now)
+		if err != nil {
+			return nil, err
+		}
+		return &list, nil
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+	res := make([]*model.FlashSale, 0, len(*data))
+	for _, fs := range *data {
+		if fs.EndTime.After(now) {
+			res = append(res, fs)
+		}
+	}
+	return res, nil
+}
+
+// DelGoods 商品及其规格、定时调价新增、修改或删除后清除缓存
+func DelGoods(ctx context.Context, idList ...int64) {
+	keys := make([]string, 0, len(idList))
+	for _, id := range idList {
+		keys = append(keys, goodsKey(id), goodsSkuKey(id), pricePlanKey(id))
+	}
+	del(ctx, keys...)
+	notify(ctx, &Invalidation{GoodsIds: idList})
+}
+
+// DelRoomGoods 直播间商品挂载、移除、排序或切换讲解后清除缓存
+func DelRoomGoods(ctx context.Context, roomId int64) {
+	del(ctx, roomGoodsKey(roomId))
+	notify(ctx, &Invalidation{RoomId: roomId})
+}
+
+// DelRoomFlashSale 直播间新增秒杀场次后清除缓存
+func DelRoomFlashSale(ctx context.Context, roomId int64) {
+	del(ctx, roomFlashSaleKey(roomId))
+	notify(ctx, &Invalidation{RoomId: roomId})
+}
+
+func goodsSkuKey(goodsId int64) string {
+	return fmt.Sprintf("xx_goods_sku:%d", goodsId)
+}
+
+// GoodsSku 商品的规格项和规格，整体缓存
+type GoodsSku struct {
+	Specs []*model.GoodsSpec
+	Skus  []*model.GoodsSku
+}
+
+// GetGoodsSku 查询商品的规格项和规格，没有规格的商品返回空列表
+func GetGoodsSku(ctx context.Context, goodsId int64) (*GoodsSku, error) {
+	return load(ctx, goodsSkuKey(goodsId), func(ctx context.Context) (*GoodsSku, error) {
+		specs, err := mysql.GetSpecsByGoodsId(ctx, goodsId)
+		if err != nil {
+			return nil, err
+		}
+		skus, err := mysql.GetSkusByGoodsId(ctx, goodsId)
+		if err != nil {
+			return nil, err
+		}
+		return &GoodsSku{Specs: specs, Skus: skus}, nil
+	})
+}
+
+func pricePlanKey(goodsId int64) string {
+	return fmt.Sprintf("xx_goods_price_plan:%d", goodsId)
+}
+
+// PricePlan 商品待生效的定时调价，整体缓存
+type PricePlan struct {
+	Prices []*model.GoodsPrice
+}
+
+// GetPricePlan 查询商品待生效的定时调价
+func GetPricePlan(ctx context.Context, goodsId int64) (*PricePlan, error) {
+	return load(ctx, pricePlanKey(goodsId), func(ctx context.Context) (*PricePlan, error) {
+		prices, err := mysql.GetPendingPrices(ctx, goodsId)
+		if err != nil {
+			return nil, err
+		}
+		return &PricePlan{Prices: prices}, nil
+	})
+}
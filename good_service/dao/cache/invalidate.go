@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"good_service/dao/redis"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// 缓存失效通知的广播频道
+const invalidateChannel = "xx_goods_cache_invalidate"
+
+// Invalidation 缓存失效通知，在实例间广播，各实例收到后清除自己的本地缓存
+type Invalidation struct {
+	GoodsIds []int64 `json:"goods_ids,omitempty"`
+	RoomId   int64   `json:"room_id,omitempty"`
+}
+
+var (
+	mu        sync.RWMutex
+	listeners []func(*Invalidation)
+)
+
+// OnInvalidate 注册缓存失效的回调
+func OnInvalidate(fn func(*Invalidation)) {
+	mu.Lock()
+	defer mu.Unlock()
+	listeners = append(listeners, fn)
+}
+
+func dispatch(inv *Invalidation) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, fn := range listeners {
+		fn(inv)
+	}
+}
+
+// notify 先清除本实例的本地缓存，再通知其他实例
+// 发布订阅不保证送达，本地缓存需要设置较短的过期时间兜底
+func notify(ctx context.Context, inv *Invalidation) {
+	dispatch(inv)
+	b, err := json.Marshal(inv)
+	if err != nil {
+		return
+	}
+	if err := redis.Rdb.Publish(ctx, invalidateChannel, b).Err(); err != nil {
+		zap.L().Error("cache.notify failed", zap.ByteString("msg", b), zap.Error(err))
+	}
+}
+
+// SubscribeInvalidation 订阅其他实例广播的缓存失效通知
+func SubscribeInvalidation(ctx context.Context) {
+	ps := redis.Rdb.Subscribe(ctx, invalidateChannel)
+	go func() {
+		defer ps.Close()
+		for msg := range ps.Channel() {
+			var inv Invalidation
+			if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+				continue
+			}
+			dispatch(&inv)
+		}
+	}()
+}
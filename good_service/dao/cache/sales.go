@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"good_service/dao/mysql"
+	"good_service/dao/redis"
+	"strconv"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 直播间销量排行的sorted set，member为goods_id，score为销量
+// 排行不过期，key不存在时从MySQL重建，占位member用于区分空排行和未重建
+func roomSalesKey(roomId int64) string {
+	return fmt.Sprintf("xx_room_sales:%d", roomId)
+}
+
+const salesPlaceholder = "0"
+
+// 排行已存在时才累加，避免在未重建的key上只记录部分销量
+var incrRoomSalesScript = goredis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('ZINCRBY', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// IncrRoomSales 累加商品在直播间的销量排行
+func IncrRoomSales(ctx context.Context, roomId, goodsId, num int64) error {
+	return incrRoomSalesScript.Run(ctx, redis.Rdb, []string{roomSalesKey(roomId)}, num, goodsId).Err()
+}
+
+// RoomSales 商品在直播间的销量
+type RoomSales struct {
+	GoodsId int64
+	Sales   int64
+}
+
+// GetRoomSalesRank 查询直播间销量前limit的商品，limit为0时返回全部有销量的商品
+func GetRoomSalesRank(ctx context.Context, roomId int64, limit int64) ([]*RoomSales, error) {
+	key := roomSalesKey(roomId)
+	n, err := redis.Rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		if err := rebuildRoomSales(ctx, roomId); err != nil {
+			return nil, err
+		}
+	}
+	list, err := redis.Rdb.ZRevRangeByScoreWithScores(ctx, key, &goredis.ZRangeBy{
+		Min:   "1",
+		Max:   "+inf",
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*RoomSales, 0, len(list))
+	for _, z := range list {
+		goodsId, err := strconv.ParseInt(fmt.Sprint(z.Member), 10, 64)
+		if err != nil || goodsId <= 0 {
+			continue
+		}
+		data = append(data, &RoomSales{GoodsId: goodsId, Sales: int64(z.Score)})
+	}
+	return data, nil
+}
+
+func rebuildRoomSales(ctx context.Context, roomId int64) error {
+	list, err := mysql.GetRoomGoodsSales(ctx, roomId)
+	if err != nil {
+		return err
+	}
+	members := make([]goredis.Z, 0, len(list)+1)
+	members = append(members, goredis.Z{Member: salesPlaceholder, Score: 0})
+	for _, rg := range list {
+		members = append(members, goredis.Z{Member: rg.GoodsId, Score: float64(rg.Sales)})
+	}
+	key := roomSalesKey(roomId)
+	_, err = redis.Rdb.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZAdd(ctx, key, members...)
+		return nil
+	})
+	return err
+}
+
+// DelRoomSales 直播间移除商品后清除销量排行，下次查询时重建
+func DelRoomSales(ctx context.Context, roomId int64) {
+	del(ctx, roomSalesKey(roomId))
+}
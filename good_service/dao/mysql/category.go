@@ -0,0 +1,232 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"good_service/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateCategory 创建类目，创建后根据自增id生成类目路径
+func CreateCategory(ctx context.Context, data *model.Category, parentPath string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(data).Error; err != nil {
+			return err
+		}
+		data.Path = fmt.Sprintf("%s%d/", parentPath, data.ID)
+		return tx.Model(data).Update("path", data.Path).Error
+	})
+}
+
+// GetCategory 根据id查询类目
+func GetCategory(ctx context.Context, id int64) (*model.Category, error) {
+	var data model.Category
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("id = ? and is_del = 0", id).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetCategoryByIds 批量查询类目
+func GetCategoryByIds(ctx context.Context, idList []int64) ([]*model.Category, error) {
+	var data []*model.Category
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("id in ? and is_del = 0", idList).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetAllCategory 查询所有类目，按层级和排序返回
+func GetAllCategory(ctx context.Context) ([]*model.Category, error) {
+	var data []*model.Category
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("is_del = 0").
+		Order("level, sort, id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetCategoryTreeIds 查询类目及其所有子类目的id
+func GetCategoryTreeIds(ctx context.Context, path string) ([]int64, error) {
+	var data []int64
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("path like ? and is_del = 0", escapeLike(path)+"%").
+		Pluck("id", &data).Error
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ExistCategoryName 判断同级类目下是否已有同名类目，excludeId为修改时自身的id
+func ExistCategoryName(ctx context.Context, parentId int64, name string, excludeId int64) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("parent_id = ? and name = ? and id <> ? and is_del = 0", parentId, name, excludeId).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ExistChildCategory 判断类目下是否有子类目
+func ExistChildCategory(ctx context.Context, id int64) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("parent_id = ? and is_del = 0", id).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UpdateCategory 修改类目的名称、排序和启用状态
+func UpdateCategory(ctx context.Context, data *model.Category) error {
+	return db.WithContext(ctx).
+		Model(&model.Category{}).
+		Where("id = ? and is_del = 0", data.ID).
+		Updates(map[string]interface{}{
+			"name":      data.Name,
+			"sort":      data.Sort,
+			"status":    data.Status,
+			"update_by": data.UpdateBy,
+		}).Error
+}
+
+func CreateBrand(ctx context.Context, data *model.Brand) error {
+	return db.WithContext(ctx).Create(data).Error
+}
+
+// GetBrand 根据id查询品牌
+func GetBrand(ctx context.Context, id int64) (*model.Brand, error) {
+	var data model.Brand
+	err := db.WithContext(ctx).
+		Model(&model.Brand{}).
+		Where("id = ? and is_del = 0", id).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetBrandByName 根据名称查询品牌
+func GetBrandByName(ctx context.Context, name string) (*model.Brand, error) {
+	var data model.Brand
+	err := db.WithContext(ctx).
+		Model(&model.Brand{}).
+		Where("name = ? and is_del = 0", name).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// UpdateBrand 修改品牌，改名时在同一事务中把商品上的品牌名改为新名称，返回改名的商品
+func UpdateBrand(ctx context.Context, data *model.Brand, oldName string) ([]int64, error) {
+	var goodsIds []int64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Brand{}).
+			Where("id = ? and is_del = 0", data.ID).
+			Updates(map[string]interface{}{
+				"name":      data.Name,
+				"logo":      data.Logo,
+				"status":    data.Status,
+				"update_by": data.UpdateBy,
+			}).Error
+		if err != nil || data.Name == oldName {
+			return err
+		}
+
+		err = tx.Model(&model.Goods{}).
+			Where("brand_name = ? and is_del = 0", oldName).
+			Pluck("goods_id", &goodsIds).Error
+		if err != nil || len(goodsIds) == 0 {
+			return err
+		}
+		// 版本号加一，让按旧品牌名编辑中的商品提交时失败
+		return tx.Model(&model.Goods{}).
+			Where("goods_id in ?", goodsIds).
+			Updates(map[string]interface{}{
+				"brand_name": data.Name,
+				"update_by":  data.UpdateBy,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+	})
+	return goodsIds, err
+}
+
+// ListBrand 按名称和状态分页查询品牌
+func ListBrand(ctx context.Context, name string, status *int32, offset, limit int) ([]*model.Brand, int64, error) {
+	query := db.WithContext(ctx).
+		Model(&model.Brand{}).
+		Where("is_del = 0")
+	if len(name) > 0 {
+		query = query.Where("name like ?", "%"+escapeLike(name)+"%")
+	}
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var data []*model.Brand
+	err := query.Order("id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+// GetCategoryAttrs 查询多个类目的属性
+func GetCategoryAttrs(ctx context.Context, categoryIds []int64) ([]*model.CategoryAttr, error) {
+	var data []*model.CategoryAttr
+	err := db.WithContext(ctx).
+		Model(&model.CategoryAttr{}).
+		Where("category_id in ? and is_del = 0", categoryIds).
+		Order("sort, id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SaveCategoryAttrs 整体替换类目自身的属性
+func SaveCategoryAttrs(ctx context.Context, categoryId int64, attrs []*model.CategoryAttr) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 属性没有被其他表引用，直接删除重建
+		err := tx.Where("category_id = ?", categoryId).Delete(&model.CategoryAttr{}).Error
+		if err != nil {
+			return err
+		}
+		if len(attrs) == 0 {
+			return nil
+		}
+		return tx.Create(attrs).Error
+	})
+}
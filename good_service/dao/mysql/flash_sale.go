@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"good_service/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreateFlashSale 创建秒杀场次
+func CreateFlashSale(ctx context.Context, data *model.FlashSale) error {
+	return db.WithContext(ctx).Create(data).Error
+}
+
+// ExistFlashSaleOverlap 判断直播间商品在指定时间段内是否已有秒杀场次
+func ExistFlashSaleOverlap(ctx context.Context, roomId, goodsId int64, start, end time.Time) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&model.FlashSale{}).
+		Where("room_id = ? and goods_id = ? and start_time < ? and end_time > ? and is_del = 0", roomId, goodsId, end, start).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetRoomFlashSale 查询直播间尚未结束的全部秒杀场次，按开始时间排序
+func GetRoomFlashSale(ctx context.Context, roomId int64, now time.Time) ([]*model.FlashSale, error) {
+	var data []*model.FlashSale
+	err := db.WithContext(ctx).
+		Model(&model.FlashSale{}).
+		Where("room_id = ? and end_time > ? and is_del = 0", roomId, now).
+		Order("start_time").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, errors.New("query mysql failed")
+	}
+	return data, nil
+}
@@ -3,7 +3,12 @@ package mysql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"good_service/model"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -14,7 +19,7 @@ func GetGoodsByRoomId(ctx context.Context, roomId int64) ([]*model.RoomGoods, er
 	var data []*model.RoomGoods
 	err := db.WithContext(ctx).
 		Model(&model.RoomGoods{}).
-		Where("room_id = ?", roomId).
+		Where("room_id = ? and is_del = 0", roomId).
 		Order("weight").
 		Find(&data).Error
 	// 查询出错或则是空数据，返回错误
@@ -29,7 +34,7 @@ func GetGoodsById(ctx context.Context, idList []int64) ([]*model.Goods, error) {
 	var data []*model.Goods
 	err := db.WithContext(ctx).
 		Model(&model.Goods{}).
-		Where("goods_id in ? ", idList).
+		Where("goods_id in ? and is_del = 0", idList).
 		Clauses(clause.OrderBy{
 			Expression: clause.Expr{SQL: "FIELD(goods_id, ?)", Vars: []interface{}{idList}, WithoutParentheses: true},
 		}).
@@ -40,16 +45,225 @@ func GetGoodsById(ctx context.Context, idList []int64) ([]*model.Goods, error) {
 	return data, nil
 }
 
-func GetGoodsDetail(ctx context.Context, goodsId int64) (*model.Goods, error) {
-	var data model.Goods
+// GetGoodsAfter 按goods_id顺序分批查询商品，用于遍历全部商品
+func GetGoodsAfter(ctx context.Context, lastGoodsId int64, limit int) ([]*model.Goods, error) {
+	var data []*model.Goods
 	err := db.WithContext(ctx).
 		Model(&model.Goods{}).
-		Where("id = ?", goodsId).
-		First(&data).
-		Error
+		Where("goods_id > ? and is_del = 0", lastGoodsId).
+		Order("goods_id").
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
 
-	if err != nil || err == gorm.ErrRecordNotFound {
+// GetDueShelfGoods 查询定时上架或下架时间已到的商品
+func GetDueShelfGoods(ctx context.Context, now time.Time, limit int) ([]*model.Goods, error) {
+	var data []*model.Goods
+	err := db.WithContext(ctx).
+		Model(&model.Goods{}).
+		Where("(on_shelf_at <= ? or off_shelf_at <= ?) and is_del = 0", now, now).
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SetShelfStatus 定时上下架，同时清空已到期的定时时间
+// 根据版本号更新，多个实例同时执行时只有一个会成功，返回是否更新成功
+func SetShelfStatus(ctx context.Context, data *model.Goods, status int8, clearOn, clearOff bool) (bool, error) {
+	values := map[string]interface{}{
+		"status":    status,
+		"update_by": "system",
+		"version":   data.Version + 1,
+	}
+	if clearOn {
+		values["on_shelf_at"] = nil
+	}
+	if clearOff {
+		values["off_shelf_at"] = nil
+	}
+	res := db.WithContext(ctx).
+		Model(&model.Goods{}).
+		Where("goods_id = ? and version = ? and is_del = 0", data.GoodsId, data.Version).
+		Updates(values)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// GetRoomGoods 查询直播间内的指定商品
+func GetRoomGoods(ctx context.Context, roomId, goodsId int64) (*model.RoomGoods, error) {
+	var data model.RoomGoods
+	err := db.WithContext(ctx).
+		Model(&model.RoomGoods{}).
+		Where("room_id = ? and goods_id = ? and is_del = 0", roomId, goodsId).
+		First(&data).Error
+	if err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
+
+var ErrGoodsNotFound = errors.New("goods not found")
+
+// GoodsFilter 商品列表的查询条件
+type GoodsFilter struct {
+	CategoryIds []int64           // 类目及其子类目
+	Attrs       map[string]string // 扩展属性精确匹配
+	BrandName   string
+	Status      *int32
+	Title       string
+	Offset      int
+	Limit       int
+}
+
+// CreateGoods 创建商品，并记录初始价格
+func CreateGoods(ctx context.Context, data *model.Goods) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(data).Error; err != nil {
+			return err
+		}
+		return tx.Create(priceRecord(data.GoodsId, 0, data.Price, data.MarketPrice, data.CreateBy)).Error
+	})
+}
+
+// GetGoodsByGoodsId 根据goods_id查询未删除的商品
+func GetGoodsByGoodsId(ctx context.Context, goodsId int64) (*model.Goods, error) {
+	var data model.Goods
+	err := db.WithContext(ctx).
+		Model(&model.Goods{}).
+		Where("goods_id = ? and is_del = 0", goodsId).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// UpdateGoods 根据版本号更新商品，版本号不一致时说明已被修改
+// priceChanged为true时同时记录价格变更
+func UpdateGoods(ctx context.Context, data *model.Goods, priceChanged bool) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := updateGoods(tx, data); err != nil {
+			return err
+		}
+		if !priceChanged {
+			return nil
+		}
+		return tx.Create(priceRecord(data.GoodsId, 0, data.Price, data.MarketPrice, data.UpdateBy)).Error
+	})
+}
+
+func updateGoods(tx *gorm.DB, data *model.Goods) error {
+	res := tx.Model(&model.Goods{}).
+		Where("goods_id = ? and version = ? and is_del = 0", data.GoodsId, data.Version).
+		Updates(map[string]interface{}{
+			"category_id":  data.CategoryId,
+			"brand_name":   data.BrandName,
+			"code":         data.Code,
+			"status":       data.Status,
+			"title":        data.Title,
+			"market_price": data.MarketPrice,
+			"price":        data.Price,
+			"brief":        data.Brief,
+			"head_imgs":    data.HeadImgs,
+			"videos":       data.Videos,
+			"head_img_ids": data.HeadImgIds,
+			"video_ids":    data.VideoIds,
+			"detail":       data.Detail,
+			"ext_json":     data.ExtJson,
+			"on_shelf_at":  data.OnShelfAt,
+			"off_shelf_at": data.OffShelfAt,
+			"update_by":    data.UpdateBy,
+			"version":      data.Version + 1,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return ErrGoodsNotFound
+	}
+	data.Version++
+	return nil
+}
+
+// DeleteGoods 软删除商品
+func DeleteGoods(ctx context.Context, goodsId int64, operator string) error {
+	res := db.WithContext(ctx).
+		Model(&model.Goods{}).
+		Where("goods_id = ? and is_del = 0", goodsId).
+		Updates(map[string]interface{}{
+			"is_del":    1,
+			"update_by": operator,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return ErrGoodsNotFound
+	}
+	return nil
+}
+
+// ListGoods 按条件分页查询商品
+func ListGoods(ctx context.Context, f *GoodsFilter) ([]*model.Goods, int64, error) {
+	query := db.WithContext(ctx).
+		Model(&model.Goods{}).
+		Where("is_del = 0")
+	if len(f.CategoryIds) > 0 {
+		query = query.Where("category_id in ?", f.CategoryIds)
+	}
+	if len(f.BrandName) > 0 {
+		query = query.Where("brand_name = ?", f.BrandName)
+	}
+	if f.Status != nil {
+		query = query.Where("status = ?", *f.Status)
+	}
+	if len(f.Title) > 0 {
+		query = query.Where("title like ?", "%"+escapeLike(f.Title)+"%")
+	}
+	// 属性名由调用方校验，只包含字母、数字和下划线；历史数据的ext_json可能不是json，按空对象处理
+	// 过滤值是数字时，数字类型的属性按数值比较，"1.0"能匹配1
+	for name, value := range f.Attrs {
+		path := fmt.Sprintf(`$."%s"`, name)
+		if !isNumber(value) {
+			query = query.Where("JSON_UNQUOTE(JSON_EXTRACT(IF(JSON_VALID(ext_json), ext_json, '{}'), ?)) = ?", path, value)
+			continue
+		}
+		query = query.Where("((JSON_TYPE(JSON_EXTRACT(IF(JSON_VALID(ext_json), ext_json, '{}'), ?)) in ('INTEGER', 'UNSIGNED INTEGER', 'DOUBLE', 'DECIMAL')"+
+			" and CAST(JSON_EXTRACT(IF(JSON_VALID(ext_json), ext_json, '{}'), ?) AS DECIMAL(65,10)) = CAST(? AS DECIMAL(65,10)))"+
+			" or JSON_UNQUOTE(JSON_EXTRACT(IF(JSON_VALID(ext_json), ext_json, '{}'), ?)) = ?)", path, path, value, path, value)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var data []*model.Goods
+	err := query.Order("id desc").
+		Offset(f.Offset).
+		Limit(f.Limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+// isNumber 是否是有限的数字
+func isNumber(s string) bool {
+	f, err := strconv.ParseFloat(s, 64)
+	return err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// escapeLike 转义like查询中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
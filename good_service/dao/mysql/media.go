@@ -0,0 +1,26 @@
+package mysql
+
+import (
+	"context"
+	"good_service/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateMedia 保存上传的媒体
+func CreateMedia(ctx context.Context, data *model.Media) error {
+	return db.WithContext(ctx).Create(data).Error
+}
+
+// GetMediaByIds 根据媒体id批量查询
+func GetMediaByIds(ctx context.Context, idList []int64) ([]*model.Media, error) {
+	var data []*model.Media
+	err := db.WithContext(ctx).
+		Model(&model.Media{}).
+		Where("media_id in ? and is_del = 0", idList).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
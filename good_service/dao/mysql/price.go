@@ -0,0 +1,165 @@
+package mysql
+
+import (
+	"context"
+	"good_service/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// priceRecord 立即生效的价格变更记录
+func priceRecord(goodsId, skuId, price, marketPrice int64, operator string) *model.GoodsPrice {
+	data := &model.GoodsPrice{
+		GoodsId:     goodsId,
+		SkuId:       skuId,
+		Price:       price,
+		MarketPrice: marketPrice,
+		EffectiveAt: time.Now(),
+		Status:      model.PriceApplied,
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	return data
+}
+
+// CreatePrice 创建定时调价
+func CreatePrice(ctx context.Context, data *model.GoodsPrice) error {
+	return db.WithContext(ctx).Create(data).Error
+}
+
+// GetPriceHistory 分页查询商品的价格变更记录，按生效时间倒序，skuId为nil时查询商品和所有规格
+func GetPriceHistory(ctx context.Context, goodsId int64, skuId *int64, offset, limit int) ([]*model.GoodsPrice, int64, error) {
+	query := db.WithContext(ctx).
+		Model(&model.GoodsPrice{}).
+		Where("goods_id = ? and is_del = 0", goodsId)
+	if skuId != nil {
+		query = query.Where("sku_id = ?", *skuId)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var data []*model.GoodsPrice
+	err := query.Order("effective_at desc, id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+// GetPendingPrices 查询商品待生效的定时调价，按生效时间排序
+func GetPendingPrices(ctx context.Context, goodsId int64) ([]*model.GoodsPrice, error) {
+	var data []*model.GoodsPrice
+	err := db.WithContext(ctx).
+		Model(&model.GoodsPrice{}).
+		Where("goods_id = ? and status = ? and is_del = 0", goodsId, model.PriceScheduled).
+		Order("effective_at, id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetDuePrices 查询生效时间已到的定时调价
+func GetDuePrices(ctx context.Context, now time.Time, limit int) ([]*model.GoodsPrice, error) {
+	var data []*model.GoodsPrice
+	err := db.WithContext(ctx).
+		Model(&model.GoodsPrice{}).
+		Where("status = ? and effective_at <= ? and is_del = 0", model.PriceScheduled, now).
+		Order("effective_at, id").
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ApplyPrice 定时调价生效，修改商品或规格的价格，规格已删除时取消该调价
+// 多个实例同时执行时只有一个会成功，返回调价后的状态，未处理时返回待生效
+func ApplyPrice(ctx context.Context, data *model.GoodsPrice) (int8, error) {
+	status := int8(model.PriceScheduled)
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var res *gorm.DB
+		if data.SkuId > 0 {
+			res = tx.Model(&model.GoodsSku{}).
+				Where("sku_id = ? and goods_id = ? and is_del = 0", data.SkuId, data.GoodsId).
+				Updates(map[string]interface{}{
+					"price":        data.Price,
+					"market_price": data.MarketPrice,
+					"update_by":    data.CreateBy,
+				})
+		} else {
+			res = tx.Model(&model.Goods{}).
+				Where("goods_id = ? and is_del = 0", data.GoodsId).
+				Updates(map[string]interface{}{
+					"price":        data.Price,
+					"market_price": data.MarketPrice,
+					"update_by":    data.CreateBy,
+					"version":      gorm.Expr("version + 1"),
+				})
+		}
+		if res.Error != nil {
+			return res.Error
+		}
+		newStatus := int8(model.PriceApplied)
+		if res.RowsAffected < 1 {
+			newStatus = model.PriceCanceled
+		}
+
+		res = tx.Model(&model.GoodsPrice{}).
+			Where("id = ? and status = ?", data.ID, model.PriceScheduled).
+			Updates(map[string]interface{}{
+				"status":    newStatus,
+				"update_by": "system",
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		// 已被其他实例处理，回滚本次修改
+		if res.RowsAffected < 1 {
+			return gorm.ErrRecordNotFound
+		}
+		status = newStatus
+		if data.SkuId > 0 && newStatus == model.PriceApplied {
+			return syncGoodsPrice(tx, data.GoodsId)
+		}
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return model.PriceScheduled, nil
+	}
+	if err != nil {
+		return model.PriceScheduled, err
+	}
+	return status, nil
+}
+
+// syncGoodsPrice 商品的售价和市场价同步为在售规格中的最低价，用于列表展示
+func syncGoodsPrice(tx *gorm.DB, goodsId int64) error {
+	var sku model.GoodsSku
+	err := tx.Model(&model.GoodsSku{}).
+		Where("goods_id = ? and status = 0 and is_del = 0", goodsId).
+		Order("price, id").
+		First(&sku).Error
+	// 没有在售规格时保留原价格
+	if err == gorm.ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Model(&model.Goods{}).
+		Where("goods_id = ?", goodsId).
+		Updates(map[string]interface{}{
+			"price":        sku.Price,
+			"market_price": sku.MarketPrice,
+			"version":      gorm.Expr("version + 1"),
+		}).Error
+}
@@ -0,0 +1,101 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"good_service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewExists   = errors.New("review exists")
+)
+
+// CreateReview 创建评价，同一订单行已评价时返回ErrReviewExists
+func CreateReview(ctx context.Context, data *model.GoodsReview) error {
+	err := db.WithContext(ctx).Create(data).Error
+	if isDuplicate(err) {
+		return ErrReviewExists
+	}
+	return err
+}
+
+// ListReviews 分页查询评价，按创建时间倒序，goodsId为0时查询所有商品
+func ListReviews(ctx context.Context, goodsId int64, status int8, offset, limit int) ([]*model.GoodsReview, int64, error) {
+	query := db.WithContext(ctx).
+		Model(&model.GoodsReview{}).
+		Where("status = ? and is_del = 0", status)
+	if goodsId > 0 {
+		query = query.Where("goods_id = ?", goodsId)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var data []*model.GoodsReview
+	err := query.Order("id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+// ModerateReview 修改评价的审核状态，并在同一事务中更新商品的评价数和评分之和
+func ModerateReview(ctx context.Context, reviewId int64, status int8, operator string) (*model.GoodsReview, error) {
+	var data model.GoodsReview
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("review_id = ? and is_del = 0", reviewId).
+			First(&data).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrReviewNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if data.Status == status {
+			return nil
+		}
+
+		var count, sum int64
+		if data.Status == model.ReviewApproved {
+			count, sum = -1, -int64(data.Rating)
+		}
+		if status == model.ReviewApproved {
+			count, sum = 1, int64(data.Rating)
+		}
+		err = tx.Model(&model.GoodsReview{}).
+			Where("id = ?", data.ID).
+			Updates(map[string]interface{}{
+				"status":    status,
+				"update_by": operator,
+				"version":   data.Version + 1,
+			}).Error
+		if err != nil {
+			return err
+		}
+		data.Status = status
+		data.UpdateBy = operator
+		data.Version++
+		if count == 0 {
+			return nil
+		}
+		return tx.Model(&model.Goods{}).
+			Where("goods_id = ?", data.GoodsId).
+			Updates(map[string]interface{}{
+				"review_count": gorm.Expr("review_count + ?", count),
+				"rating_sum":   gorm.Expr("rating_sum + ?", sum),
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
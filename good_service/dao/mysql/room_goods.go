@@ -0,0 +1,181 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"good_service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 直播间商品排序权重的步长，新挂载的商品排在最后
+const roomGoodsWeightStep = 10
+
+var ErrRoomGoodsNotFound = errors.New("room goods not found")
+
+// GetRoomIdsByGoodsIds 查询挂载了这些商品的直播间
+func GetRoomIdsByGoodsIds(ctx context.Context, idList []int64) ([]int64, error) {
+	var data []int64
+	err := db.WithContext(ctx).
+		Model(&model.RoomGoods{}).
+		Where("goods_id in ? and is_del = 0", idList).
+		Distinct().
+		Pluck("room_id", &data).Error
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// lockRoomGoods 在事务中锁定直播间的商品列表，串行化同一直播间的修改
+func lockRoomGoods(tx *gorm.DB, roomId int64) ([]*model.RoomGoods, error) {
+	var data []*model.RoomGoods
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Model(&model.RoomGoods{}).
+		Where("room_id = ? and is_del = 0", roomId).
+		Order("weight").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AddRoomGoods 直播间挂载商品，已挂载的商品忽略，之前移除过的商品重新挂载
+func AddRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		list, err := lockRoomGoods(tx, roomId)
+		if err != nil {
+			return err
+		}
+		var weight int64
+		exist := make(map[int64]struct{}, len(list))
+		for _, rg := range list {
+			exist[rg.GoodsId] = struct{}{}
+			if rg.Weight > weight {
+				weight = rg.Weight
+			}
+		}
+
+		for _, goodsId := range idList {
+			if _, ok := exist[goodsId]; ok {
+				continue
+			}
+			weight += roomGoodsWeightStep
+			data := &model.RoomGoods{
+				RoomId:  roomId,
+				GoodsId: goodsId,
+				Weight:  weight,
+			}
+			data.CreateBy = operator
+			data.UpdateBy = operator
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "room_id"}, {Name: "goods_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"is_del":     0,
+					"is_current": 0,
+					"weight":     weight,
+					"update_by":  operator,
+				}),
+			}).Create(data).Error
+			if err != nil {
+				return err
+			}
+			exist[goodsId] = struct{}{}
+		}
+		return nil
+	})
+}
+
+// RemoveRoomGoods 直播间移除商品
+func RemoveRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	return db.WithContext(ctx).
+		Model(&model.RoomGoods{}).
+		Where("room_id = ? and goods_id in ? and is_del = 0", roomId, idList).
+		Updates(map[string]interface{}{
+			"is_del":     1,
+			"is_current": 0,
+			"update_by":  operator,
+		}).Error
+}
+
+// SortRoomGoods 按传入顺序重排直播间商品，未传入的商品保持原有顺序排在后面
+func SortRoomGoods(ctx context.Context, roomId int64, idList []int64, operator string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		list, err := lockRoomGoods(tx, roomId)
+		if err != nil {
+			return err
+		}
+		exist := make(map[int64]struct{}, len(list))
+		for _, rg := range list {
+			exist[rg.GoodsId] = struct{}{}
+		}
+
+		order := make([]int64, 0, len(list))
+		sorted := make(map[int64]struct{}, len(idList))
+		for _, goodsId := range idList {
+			if _, ok := exist[goodsId]; !ok {
+				return ErrRoomGoodsNotFound
+			}
+			if _, ok := sorted[goodsId]; ok {
+				continue
+			}
+			sorted[goodsId] = struct{}{}
+			order = append(order, goodsId)
+		}
+		for _, rg := range list {
+			if _, ok := sorted[rg.GoodsId]; !ok {
+				order = append(order, rg.GoodsId)
+			}
+		}
+
+		for i, goodsId := range order {
+			err := tx.Model(&model.RoomGoods{}).
+				Where("room_id = ? and goods_id = ? and is_del = 0", roomId, goodsId).
+				Updates(map[string]interface{}{
+					"weight":    int64(i+1) * roomGoodsWeightStep,
+					"update_by": operator,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetCurrentGoods 切换直播间当前讲解的商品，取消旧商品的讲解状态在同一事务中完成
+func SetCurrentGoods(ctx context.Context, roomId, goodsId int64, operator string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockRoomGoods(tx, roomId); err != nil {
+			return err
+		}
+		err := tx.Model(&model.RoomGoods{}).
+			Where("room_id = ? and is_current = 1", roomId).
+			Updates(map[string]interface{}{
+				"is_current": 0,
+				"update_by":  operator,
+			}).Error
+		if err != nil {
+			return err
+		}
+		if goodsId <= 0 {
+			return nil
+		}
+
+		res := tx.Model(&model.RoomGoods{}).
+			Where("room_id = ? and goods_id = ? and is_del = 0", roomId, goodsId).
+			Updates(map[string]interface{}{
+				"is_current": 1,
+				"update_by":  operator,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < 1 {
+			return ErrRoomGoodsNotFound
+		}
+		return nil
+	})
+}
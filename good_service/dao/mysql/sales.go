@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"good_service/model"
+
+	"gorm.io/gorm"
+)
+
+// AddSales 按订单累计商品销量和商品在直播间的销量，同一订单只累计一次
+// 返回false表示该订单已经累计过
+func AddSales(ctx context.Context, info *model.OrderPaidInfo) (bool, error) {
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		log := &model.GoodsSalesLog{OrderId: info.OrderId}
+		log.CreateBy = "system"
+		log.UpdateBy = "system"
+		if err := tx.Create(log).Error; err != nil {
+			return err
+		}
+		for _, line := range info.Lines {
+			err := tx.Model(&model.Goods{}).
+				Where("goods_id = ?", line.GoodsId).
+				Update("sales", gorm.Expr("sales + ?", line.Num)).Error
+			if err != nil {
+				return err
+			}
+			if line.RoomId <= 0 {
+				continue
+			}
+			// 商品已从直播间移除时也累计，重新挂载后销量保留
+			err = tx.Model(&model.RoomGoods{}).
+				Where("room_id = ? and goods_id = ?", line.RoomId, line.GoodsId).
+				Update("sales", gorm.Expr("sales + ?", line.Num)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if isDuplicate(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetRoomGoodsSales 查询直播间有销量的商品，用于重建销量排行
+func GetRoomGoodsSales(ctx context.Context, roomId int64) ([]*model.RoomGoods, error) {
+	var data []*model.RoomGoods
+	err := db.WithContext(ctx).
+		Model(&model.RoomGoods{}).
+		Where("room_id = ? and sales > 0 and is_del = 0", roomId).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
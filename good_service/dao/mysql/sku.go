@@ -0,0 +1,122 @@
+package mysql
+
+import (
+	"context"
+	"good_service/model"
+
+	"gorm.io/gorm"
+)
+
+// GetSpecsByGoodsId 查询商品的规格项
+func GetSpecsByGoodsId(ctx context.Context, goodsId int64) ([]*model.GoodsSpec, error) {
+	var data []*model.GoodsSpec
+	err := db.WithContext(ctx).
+		Model(&model.GoodsSpec{}).
+		Where("goods_id = ? and is_del = 0", goodsId).
+		Order("sort").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetSkusByGoodsId 查询商品的所有规格
+func GetSkusByGoodsId(ctx context.Context, goodsId int64) ([]*model.GoodsSku, error) {
+	var data []*model.GoodsSku
+	err := db.WithContext(ctx).
+		Model(&model.GoodsSku{}).
+		Where("goods_id = ? and is_del = 0", goodsId).
+		Order("id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SaveGoodsSku 整体替换商品的规格项和规格，不在新列表中的规格软删除，同时记录规格的价格变更
+// 商品的售价和市场价同步为在售规格中的最低价，用于列表展示
+func SaveGoodsSku(ctx context.Context, goodsId int64, specs []*model.GoodsSpec, skus []*model.GoodsSku, prices []*model.GoodsPrice, operator string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Goods{}).
+			Where("goods_id = ? and is_del = 0", goodsId).
+			Update("update_by", operator)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < 1 {
+			return ErrGoodsNotFound
+		}
+
+		// 规格项没有被其他表引用，直接删除重建
+		err := tx.Where("goods_id = ?", goodsId).Delete(&model.GoodsSpec{}).Error
+		if err != nil {
+			return err
+		}
+		if len(specs) > 0 {
+			if err := tx.Create(specs).Error; err != nil {
+				return err
+			}
+		}
+
+		// 规格被库存和订单引用，保留sku_id，只更新或软删除
+		keep := make([]int64, 0, len(skus))
+		for _, sku := range skus {
+			keep = append(keep, sku.SkuId)
+		}
+		query := tx.Model(&model.GoodsSku{}).Where("goods_id = ? and is_del = 0", goodsId)
+		if len(keep) > 0 {
+			query = query.Where("sku_id not in ?", keep)
+		}
+		err = query.Updates(map[string]interface{}{
+			"is_del":    1,
+			"update_by": operator,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var exist []int64
+		err = tx.Model(&model.GoodsSku{}).
+			Where("goods_id = ?", goodsId).
+			Pluck("sku_id", &exist).Error
+		if err != nil {
+			return err
+		}
+		existSet := make(map[int64]struct{}, len(exist))
+		for _, id := range exist {
+			existSet[id] = struct{}{}
+		}
+
+		for _, sku := range skus {
+			if _, ok := existSet[sku.SkuId]; !ok {
+				if err := tx.Create(sku).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			err := tx.Model(&model.GoodsSku{}).
+				Where("sku_id = ? and goods_id = ?", sku.SkuId, goodsId).
+				Updates(map[string]interface{}{
+					"specs":        sku.Specs,
+					"code":         sku.Code,
+					"image":        sku.Image,
+					"market_price": sku.MarketPrice,
+					"price":        sku.Price,
+					"status":       sku.Status,
+					"is_del":       0,
+					"update_by":    operator,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(prices) > 0 {
+			if err := tx.Create(prices).Error; err != nil {
+				return err
+			}
+		}
+		return syncGoodsPrice(tx, goodsId)
+	})
+}
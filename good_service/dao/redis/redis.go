@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"good_service/config"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+var Rdb *redis.Client
+
+func Init(cfg *config.RedisConfig) error {
+	rc := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Password: cfg.Password,
+		DB:       cfg.Db,
+		PoolSize: cfg.PoolSize,
+	})
+
+	err := rc.Ping(context.Background()).Err()
+	if err != nil {
+		return err
+	}
+
+	zap.L().Info("Init Redis Success!")
+	Rdb = rc
+	return nil
+}
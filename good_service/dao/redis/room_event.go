@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"good_service/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func roomEventChannel(roomId int64) string {
+	return fmt.Sprintf("xx_room_event:%d", roomId)
+}
+
+// PublishRoomEvent 发布直播间商品变化事件
+func PublishRoomEvent(ctx context.Context, event *model.RoomEvent) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return Rdb.Publish(ctx, roomEventChannel(event.RoomId), b).Err()
+}
+
+// RoomEventSub 本实例的直播间事件订阅，一个实例共用一个订阅连接，按直播间增减频道
+type RoomEventSub struct {
+	ps *redis.PubSub
+}
+
+func NewRoomEventSub(ctx context.Context) *RoomEventSub {
+	return &RoomEventSub{ps: Rdb.Subscribe(ctx)}
+}
+
+func (s *RoomEventSub) Subscribe(ctx context.Context, roomId int64) error {
+	return s.ps.Subscribe(ctx, roomEventChannel(roomId))
+}
+
+func (s *RoomEventSub) Unsubscribe(ctx context.Context, roomId int64) error {
+	return s.ps.Unsubscribe(ctx, roomEventChannel(roomId))
+}
+
+// Channel 返回解析后的事件，无法解析的消息直接丢弃
+func (s *RoomEventSub) Channel() <-chan *model.RoomEvent {
+	ch := make(chan *model.RoomEvent, 100)
+	go func() {
+		defer close(ch)
+		for msg := range s.ps.Channel() {
+			var event model.RoomEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			ch <- &event
+		}
+	}()
+	return ch
+}
+
+func (s *RoomEventSub) Close() error {
+	return s.ps.Close()
+}
@@ -0,0 +1,177 @@
+package search
+
+import (
+	"strconv"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+// 排序方式
+const (
+	SortScore     = iota // 相关度
+	SortPriceAsc         // 价格升序
+	SortPriceDesc        // 价格降序
+	SortSalesDesc        // 销量降序
+)
+
+var index bleve.Index
+
+// GoodsDoc 商品的索引文档，文档id为goods_id
+type GoodsDoc struct {
+	Title     string `json:"title"`
+	Brief     string `json:"brief"`
+	BrandName string `json:"brand_name"`
+	// 类目路径上的所有类目id，按上级类目搜索时能匹配到子类目下的商品
+	CategoryPath []string `json:"category_path"`
+	Price        float64  `json:"price"`
+	Status       float64  `json:"status"`
+	Sales        float64  `json:"sales"` // 销量
+}
+
+// Query 搜索条件
+type Query struct {
+	Keyword    string
+	CategoryId int64
+	MinPrice   int64
+	MaxPrice   int64
+	Status     *int32
+	SortBy     int
+	Offset     int
+	Limit      int
+}
+
+// Init 创建内存索引，索引数据由调用方从MySQL重建
+func Init() (err error) {
+	index, err = bleve.NewMemOnly(newMapping())
+	return
+}
+
+func newMapping() *mapping.IndexMappingImpl {
+	// 中文按cjk二元分词
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+	text.Store = false
+
+	kw := bleve.NewTextFieldMapping()
+	kw.Analyzer = keyword.Name
+	kw.Store = false
+
+	num := bleve.NewNumericFieldMapping()
+	num.Store = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("brief", text)
+	doc.AddFieldMappingsAt("brand_name", text)
+	doc.AddFieldMappingsAt("category_path", kw)
+	doc.AddFieldMappingsAt("price", num)
+	doc.AddFieldMappingsAt("status", num)
+	doc.AddFieldMappingsAt("sales", num)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	return m
+}
+
+// Index 写入或覆盖商品的索引
+func Index(goodsId int64, doc *GoodsDoc) error {
+	return index.Index(strconv.FormatInt(goodsId, 10), doc)
+}
+
+// BatchIndex 批量写入商品的索引
+func BatchIndex(docs map[int64]*GoodsDoc) error {
+	b := index.NewBatch()
+	for goodsId, doc := range docs {
+		if err := b.Index(strconv.FormatInt(goodsId, 10), doc); err != nil {
+			return err
+		}
+	}
+	return index.Batch(b)
+}
+
+// Delete 删除商品的索引
+func Delete(goodsId int64) error {
+	return index.Delete(strconv.FormatInt(goodsId, 10))
+}
+
+// Search 搜索商品，返回当前页的goods_id和总数
+func Search(q *Query) ([]int64, int64, error) {
+	conj := bleve.NewConjunctionQuery()
+	if len(q.Keyword) > 0 {
+		conj.AddQuery(bleve.NewDisjunctionQuery(
+			matchQuery(q.Keyword, "title", 3),
+			matchQuery(q.Keyword, "brand_name", 2),
+			matchQuery(q.Keyword, "brief", 1),
+		))
+	} else {
+		conj.AddQuery(bleve.NewMatchAllQuery())
+	}
+	if q.CategoryId > 0 {
+		tq := bleve.NewTermQuery(strconv.FormatInt(q.CategoryId, 10))
+		tq.SetField("category_path")
+		conj.AddQuery(tq)
+	}
+	if q.MinPrice > 0 || q.MaxPrice > 0 {
+		var min, max *float64
+		if q.MinPrice > 0 {
+			v := float64(q.MinPrice)
+			min = &v
+		}
+		if q.MaxPrice > 0 {
+			v := float64(q.MaxPrice)
+			max = &v
+		}
+		conj.AddQuery(numericQuery("price", min, max))
+	}
+	if q.Status != nil {
+		v := float64(*q.Status)
+		conj.AddQuery(numericQuery("status", &v, &v))
+	}
+
+	req := bleve.NewSearchRequestOptions(conj, q.Limit, q.Offset, false)
+	switch q.SortBy {
+	case SortPriceAsc:
+		req.SortBy([]string{"price", "-_score", "_id"})
+	case SortPriceDesc:
+		req.SortBy([]string{"-price", "-_score", "_id"})
+	case SortSalesDesc:
+		req.SortBy([]string{"-sales", "-_score", "_id"})
+	default:
+		req.SortBy([]string{"-_score", "_id"})
+	}
+	res, err := index.Search(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]int64, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		id, err := strconv.ParseInt(hit.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, int64(res.Total), nil
+}
+
+func matchQuery(text, field string, boost float64) query.Query {
+	mq := bleve.NewMatchQuery(text)
+	mq.SetField(field)
+	mq.SetBoost(boost)
+	// 关键词分词后需全部命中，避免二元分词后匹配过宽
+	mq.SetOperator(query.MatchQueryOperatorAnd)
+	return mq
+}
+
+// numericQuery 闭区间的数值查询，min或max为nil时不限
+func numericQuery(field string, min, max *float64) query.Query {
+	inclusive := true
+	nq := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &inclusive)
+	nq.SetField(field)
+	return nq
+}
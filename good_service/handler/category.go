@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"good_service/biz/goods"
+	"good_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCategory 创建类目
+func (s *GoodSrv) CreateCategory(ctx context.Context, req *proto.CategoryReq) (*proto.CategoryInfo, error) {
+	if req.GetParentId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.CreateCategory(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.CreateCategory failed", zap.Int64("parent_id", req.GetParentId()), zap.String("name", req.GetName()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// UpdateCategory 修改类目
+func (s *GoodSrv) UpdateCategory(ctx context.Context, req *proto.CategoryReq) (*proto.CategoryInfo, error) {
+	if req.GetCategoryId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.UpdateCategory(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.UpdateCategory failed", zap.Int64("category_id", req.GetCategoryId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// CategoryTree 类目树
+func (s *GoodSrv) CategoryTree(ctx context.Context, req *proto.CategoryTreeReq) (*proto.CategoryTreeResp, error) {
+	data, err := goods.CategoryTree(ctx, req.GetIncludeDisabled())
+	if err != nil {
+		zap.L().Error("goods.CategoryTree failed", zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// SetCategoryAttrs 设置类目属性
+func (s *GoodSrv) SetCategoryAttrs(ctx context.Context, req *proto.SetCategoryAttrsReq) (*proto.CategoryAttrsResp, error) {
+	if req.GetCategoryId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.SetCategoryAttrs(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.SetCategoryAttrs failed", zap.Int64("category_id", req.GetCategoryId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// GetCategoryAttrs 查询类目属性
+func (s *GoodSrv) GetCategoryAttrs(ctx context.Context, req *proto.GetCategoryAttrsReq) (*proto.CategoryAttrsResp, error) {
+	if req.GetCategoryId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.GetCategoryAttrs(ctx, req.GetCategoryId())
+	if err != nil {
+		zap.L().Error("goods.GetCategoryAttrs failed", zap.Int64("category_id", req.GetCategoryId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// CreateBrand 创建品牌
+func (s *GoodSrv) CreateBrand(ctx context.Context, req *proto.BrandReq) (*proto.BrandInfo, error) {
+	data, err := goods.CreateBrand(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.CreateBrand failed", zap.String("name", req.GetName()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// UpdateBrand 修改品牌
+func (s *GoodSrv) UpdateBrand(ctx context.Context, req *proto.BrandReq) (*proto.BrandInfo, error) {
+	if req.GetBrandId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.UpdateBrand(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.UpdateBrand failed", zap.Int64("brand_id", req.GetBrandId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// ListBrand 品牌列表
+func (s *GoodSrv) ListBrand(ctx context.Context, req *proto.ListBrandReq) (*proto.ListBrandResp, error) {
+	data, err := goods.ListBrand(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.ListBrand failed", zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"auth"
 	"context"
+	"errors"
 	"good_service/biz/goods"
 	"good_service/proto"
+	"strconv"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -14,15 +17,27 @@ type GoodSrv struct {
 	proto.UnimplementedGoodsServer
 }
 
+// operator 操作人，记录到审计字段，取自登录令牌中的用户，服务间调用时为调用方的服务名
+func operator(ctx context.Context) string {
+	ident, ok := auth.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ident.UserId > 0 {
+		return strconv.FormatInt(ident.UserId, 10)
+	}
+	return ident.Service
+}
+
 // GetGoodsByRoom 根据room_id获取直播间商品列表
 func (s *GoodSrv) GetGoodsByRoom(ctx context.Context, req *proto.GetGoodsByRoomReq) (*proto.GoodsListResp, error) {
 	// 参数处理
-	if req.GetRoomId() <= 0 {
+	if req.GetRoomId() <= 0 || (req.GetSortBy() != goods.RoomSortWeight && req.GetSortBy() != goods.RoomSortSales) {
 		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
 	}
 
 	// 查询数据并封装返回的响应数据
-	data, err := goods.GetGoodsByRoomId(ctx, req.GetRoomId())
+	data, err := goods.GetGoodsByRoomId(ctx, req.GetRoomId(), req.GetSortBy())
 	if err != nil {
 		zap.L().Error("goods.GetGoodsByRoomId failed", zap.Error(err))
 		return nil, status.Error(codes.Internal, "内部错误")
@@ -31,15 +46,274 @@ func (s *GoodSrv) GetGoodsByRoom(ctx context.Context, req *proto.GetGoodsByRoomR
 	return data, nil
 }
 
+// GetRoomSalesRank 直播间销量排行
+func (s *GoodSrv) GetRoomSalesRank(ctx context.Context, req *proto.RoomSalesRankReq) (*proto.GoodsListResp, error) {
+	if req.GetRoomId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.GetRoomSalesRank(ctx, req.GetRoomId(), int(req.GetLimit()))
+	if err != nil {
+		zap.L().Error("goods.GetRoomSalesRank failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
 func (s *GoodSrv) GetGoodsDetail(ctx context.Context, req *proto.GetGoodsDetailReq) (*proto.GoodsDetail, error) {
 	if req.GetGoodsId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
 	}
 
-	data, err := goods.GetGoodsDetail(ctx, req.GetGoodsId())
+	data, err := goods.GetGoodsDetail(ctx, req.GetGoodsId(), req.GetRoomId())
 	if err != nil {
 		zap.L().Error("goods.GetGoodDetail failed", zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// BatchGetGoodsDetail 批量获取商品详情，单次最多100个
+func (s *GoodSrv) BatchGetGoodsDetail(ctx context.Context, req *proto.BatchGetGoodsDetailReq) (*proto.BatchGetGoodsDetailResp, error) {
+	if len(req.GetItems()) == 0 || len(req.GetItems()) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	for _, item := range req.GetItems() {
+		if item.GetGoodsId() <= 0 || item.GetRoomId() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+		}
+	}
+
+	data, err := goods.BatchGetGoodsDetail(ctx, req.GetItems())
+	if err != nil {
+		zap.L().Error("goods.BatchGetGoodsDetail failed", zap.Int("count", len(req.GetItems())), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// CreateFlashSale 创建直播间商品秒杀场次
+func (s *GoodSrv) CreateFlashSale(ctx context.Context, req *proto.CreateFlashSaleReq) (*proto.FlashSaleInfo, error) {
+	if req.GetRoomId() <= 0 || req.GetGoodsId() <= 0 || req.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := goods.CreateFlashSale(ctx, req)
+	if errors.Is(err, goods.ErrGoodsNotInRoom) || errors.Is(err, goods.ErrFlashSaleOverlap) || errors.Is(err, goods.ErrFlashSaleTimeRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("goods.CreateFlashSale failed", zap.Int64("room_id", req.GetRoomId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
 		return nil, status.Error(codes.Internal, "内部错误")
 	}
 	return data, nil
 }
+
+// goodsError 将商品管理的业务错误转换为gRPC错误
+func goodsError(err error) error {
+	var invalid *goods.InvalidGoodsError
+	if errors.As(err, &invalid) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, goods.ErrGoodsNotFound) || errors.Is(err, goods.ErrCategoryNotFound) || errors.Is(err, goods.ErrBrandNotFound) || errors.Is(err, goods.ErrReviewNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, goods.ErrReviewExists) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, goods.ErrGoodsNotInRoom) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "内部错误")
+}
+
+// CreateGoods 创建商品
+func (s *GoodSrv) CreateGoods(ctx context.Context, req *proto.GoodsReq) (*proto.GoodsDetail, error) {
+	data, err := goods.CreateGoods(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.CreateGoods failed", zap.String("title", req.GetTitle()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// UpdateGoods 更新商品
+func (s *GoodSrv) UpdateGoods(ctx context.Context, req *proto.GoodsReq) (*proto.GoodsDetail, error) {
+	if req.GetGoodsId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.UpdateGoods(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.UpdateGoods failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// SetGoodsSku 整体设置商品的规格项和规格
+func (s *GoodSrv) SetGoodsSku(ctx context.Context, req *proto.SetGoodsSkuReq) (*proto.GoodsDetail, error) {
+	if req.GetGoodsId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.SetGoodsSku(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.SetGoodsSku failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// SchedulePrice 定时调价
+func (s *GoodSrv) SchedulePrice(ctx context.Context, req *proto.SchedulePriceReq) (*proto.PriceChangeInfo, error) {
+	if req.GetGoodsId() <= 0 || req.GetSkuId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.SchedulePrice(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.SchedulePrice failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Int64("sku_id", req.GetSkuId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// GetPriceHistory 价格变更记录
+func (s *GoodSrv) GetPriceHistory(ctx context.Context, req *proto.GetPriceHistoryReq) (*proto.PriceHistoryResp, error) {
+	if req.GetGoodsId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.GetPriceHistory(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.GetPriceHistory failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// DeleteGoods 软删除商品
+func (s *GoodSrv) DeleteGoods(ctx context.Context, req *proto.DeleteGoodsReq) (*proto.GoodsBaseResp, error) {
+	if req.GetGoodsId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	err := goods.DeleteGoods(ctx, req.GetGoodsId(), operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.DeleteGoods failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return &proto.GoodsBaseResp{Code: int32(codes.OK), Msg: "删除成功"}, nil
+}
+
+// ListGoods 商品列表
+func (s *GoodSrv) ListGoods(ctx context.Context, req *proto.ListGoodsReq) (*proto.ListGoodsResp, error) {
+	data, err := goods.ListGoods(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.ListGoods failed", zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// SearchGoods 商品搜索
+func (s *GoodSrv) SearchGoods(ctx context.Context, req *proto.SearchGoodsReq) (*proto.ListGoodsResp, error) {
+	// 公开搜索只返回上架商品，商家和管理员才能查看其他状态
+	ident, ok := auth.FromContext(ctx)
+	if !ok || !ident.HasAnyRole(auth.RoleMerchant, auth.RoleAdmin) {
+		if req.Status != nil && req.GetStatus() != 0 {
+			return nil, status.Error(codes.PermissionDenied, "无权查看未上架的商品")
+		}
+		onShelf := int32(0)
+		req.Status = &onShelf
+	}
+	data, err := goods.SearchGoods(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.SearchGoods failed", zap.String("keyword", req.GetKeyword()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// AddRoomGoods 直播间挂载商品
+func (s *GoodSrv) AddRoomGoods(ctx context.Context, req *proto.RoomGoodsReq) (*proto.GoodsBaseResp, error) {
+	if req.GetRoomId() <= 0 || len(req.GetGoodsIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	err := goods.AddRoomGoods(ctx, req.GetRoomId(), req.GetGoodsIds(), operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.AddRoomGoods failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return &proto.GoodsBaseResp{Code: int32(codes.OK), Msg: "挂载成功"}, nil
+}
+
+// RemoveRoomGoods 直播间移除商品
+func (s *GoodSrv) RemoveRoomGoods(ctx context.Context, req *proto.RoomGoodsReq) (*proto.GoodsBaseResp, error) {
+	if req.GetRoomId() <= 0 || len(req.GetGoodsIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	err := goods.RemoveRoomGoods(ctx, req.GetRoomId(), req.GetGoodsIds(), operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.RemoveRoomGoods failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return &proto.GoodsBaseResp{Code: int32(codes.OK), Msg: "移除成功"}, nil
+}
+
+// SortRoomGoods 直播间商品排序
+func (s *GoodSrv) SortRoomGoods(ctx context.Context, req *proto.SortRoomGoodsReq) (*proto.GoodsBaseResp, error) {
+	if req.GetRoomId() <= 0 || len(req.GetGoodsIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	err := goods.SortRoomGoods(ctx, req.GetRoomId(), req.GetGoodsIds(), operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.SortRoomGoods failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return &proto.GoodsBaseResp{Code: int32(codes.OK), Msg: "排序成功"}, nil
+}
+
+// SetCurrentGoods 切换直播间当前讲解商品
+func (s *GoodSrv) SetCurrentGoods(ctx context.Context, req *proto.RoomGoodsReq) (*proto.GoodsBaseResp, error) {
+	if req.GetRoomId() <= 0 || req.GetGoodsId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	err := goods.SetCurrentGoods(ctx, req.GetRoomId(), req.GetGoodsId(), operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.SetCurrentGoods failed", zap.Int64("room_id", req.GetRoomId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return &proto.GoodsBaseResp{Code: int32(codes.OK), Msg: "切换成功"}, nil
+}
+
+// WatchRoom 订阅直播间商品变化，先推送当前状态，之后每次变化推送一次
+func (s *GoodSrv) WatchRoom(req *proto.WatchRoomReq, stream proto.Goods_WatchRoomServer) error {
+	if req.GetRoomId() <= 0 {
+		return status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	ctx := stream.Context()
+
+	// 先订阅再查询当前状态，避免两者之间的变化丢失
+	events, cancel, err := goods.WatchRoom(ctx, req.GetRoomId())
+	if err != nil {
+		zap.L().Error("goods.WatchRoom failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return status.Error(codes.Internal, "内部错误")
+	}
+	defer cancel()
+
+	snapshot, err := goods.RoomSnapshot(ctx, req.GetRoomId())
+	if err != nil {
+		zap.L().Error("goods.RoomSnapshot failed", zap.Int64("room_id", req.GetRoomId()), zap.Error(err))
+		return status.Error(codes.Internal, "内部错误")
+	}
+	if err := stream.Send(snapshot); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
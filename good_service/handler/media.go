@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"good_service/biz/goods"
+	"good_service/proto"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UploadMedia 上传商品图片或视频
+func (s *GoodSrv) UploadMedia(stream proto.Goods_UploadMediaServer) error {
+	req, err := stream.Recv()
+	if err != nil || req.GetMeta() == nil {
+		return status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	meta := req.GetMeta()
+	data, err := goods.UploadMedia(stream.Context(), &chunkReader{stream: stream}, operator(stream.Context()))
+	if err != nil {
+		zap.L().Error("goods.UploadMedia failed", zap.String("file_name", meta.GetFileName()), zap.Error(err))
+		// 读取分片时的错误已经是gRPC状态
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return goodsError(err)
+	}
+	return stream.SendAndClose(data)
+}
+
+// chunkReader 把客户端流式上传的分片转换为io.Reader
+type chunkReader struct {
+	stream proto.Goods_UploadMediaServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		if req.GetMeta() != nil {
+			return 0, status.Error(codes.InvalidArgument, "Meta只能在第一个消息中传入")
+		}
+		r.buf = req.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// MediaFileHandler 本地存储的媒体文件访问，媒体id不会复用，允许长期缓存
+func MediaFileHandler(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "" || strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"good_service/biz/goods"
+	"good_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateReview 创建评价
+func (s *GoodSrv) CreateReview(ctx context.Context, req *proto.CreateReviewReq) (*proto.ReviewInfo, error) {
+	if req.GetOrderId() <= 0 || req.GetGoodsId() <= 0 || req.GetUserId() <= 0 || req.GetSkuId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.CreateReview(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.CreateReview failed", zap.Int64("order_id", req.GetOrderId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// ModerateReview 审核评价
+func (s *GoodSrv) ModerateReview(ctx context.Context, req *proto.ModerateReviewReq) (*proto.ReviewInfo, error) {
+	if req.GetReviewId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.ModerateReview(ctx, req, operator(ctx))
+	if err != nil {
+		zap.L().Error("goods.ModerateReview failed", zap.Int64("review_id", req.GetReviewId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
+
+// ListReviews 评价列表
+func (s *GoodSrv) ListReviews(ctx context.Context, req *proto.ListReviewsReq) (*proto.ListReviewsResp, error) {
+	if req.GetGoodsId() < 0 || (req.GetGoodsId() == 0 && req.Status == nil) {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := goods.ListReviews(ctx, req)
+	if err != nil {
+		zap.L().Error("goods.ListReviews failed", zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, goodsError(err)
+	}
+	return data, nil
+}
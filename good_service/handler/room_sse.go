@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"fmt"
+	"good_service/proto"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SSE连接的心跳间隔，防止代理因为空闲断开连接
+const sseHeartbeat = 30 * time.Second
+
+// RoomEventsHandler 以SSE的方式向web端推送直播间商品变化
+// GET /v1/room/events?room_id=xxx
+func RoomEventsHandler(client proto.GoodsClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		roomId, err := strconv.ParseInt(r.URL.Query().Get("room_id"), 10, 64)
+		if err != nil || roomId <= 0 {
+			http.Error(w, "请求参数有误", http.StatusBadRequest)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "不支持流式响应", http.StatusInternalServerError)
+			return
+		}
+
+		stream, err := client.WatchRoom(r.Context(), &proto.WatchRoomReq{RoomId: roomId})
+		if err != nil {
+			zap.L().Error("client.WatchRoom failed", zap.Int64("room_id", roomId), zap.Error(err))
+			http.Error(w, "内部错误", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		// 接收协程把事件转给写协程，保证对ResponseWriter的写入都在同一个协程
+		events := make(chan *proto.RoomEvent)
+		go func() {
+			defer close(events)
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				select {
+				case events <- event:
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}()
+
+		ticker := time.NewTicker(sseHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+				fmt.Fprint(w, ": ping\n\n")
+				flusher.Flush()
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				b, err := protojson.Marshal(event)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: room\ndata: %s\n\n", b)
+				flusher.Flush()
+			}
+		}
+	}
+}
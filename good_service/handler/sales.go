@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"good_service/biz/goods"
+	"good_service/model"
+
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"go.uber.org/zap"
+)
+
+// OrderPaidHandle 订单支付成功的消息，累计销量
+func OrderPaidHandle(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+	for i := range msgs {
+		var data model.OrderPaidInfo
+		if err := json.Unmarshal(msgs[i].Body, &data); err != nil {
+			zap.L().Error("OrderPaidHandle.json.Unmarshal failed", zap.Error(err))
+			continue
+		}
+		if err := goods.AddSales(ctx, &data); err != nil {
+			zap.L().Error("goods.AddSales failed", zap.Int64("order_id", data.OrderId), zap.Error(err))
+			return consumer.ConsumeRetryLater, nil // 稍后再试
+		}
+	}
+	return consumer.ConsumeSuccess, nil
+}
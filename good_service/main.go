@@ -1,21 +1,33 @@
 package main
 
 import (
+	"auth"
 	"context"
+	"expvar"
 	"flag"
 	"fmt"
+	"good_service/biz/goods"
 	"good_service/config"
+	"good_service/dao/blob"
+	"good_service/dao/cache"
 	"good_service/dao/mysql"
+	"good_service/dao/redis"
 	"good_service/handler"
 	"good_service/logger"
+	"good_service/middleware"
 	"good_service/proto"
 	"good_service/registry"
+	"good_service/rpc"
+	"good_service/third_party/snowflake"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -52,12 +64,81 @@ func main() {
 		panic(err)
 	}
 
+	// Redis初始化
+	err = redis.Init(config.Conf.RedisConfig)
+	if err != nil {
+		panic(err)
+	}
+	// 订阅直播间商品变化事件，推送给本实例上的观众
+	goods.InitRoomHub(context.Background())
+
+	// 本地缓存初始化，并订阅其他实例的缓存失效通知
+	err = goods.InitLocalCache(config.Conf.LocalCacheConfig)
+	if err != nil {
+		panic(err)
+	}
+	cache.SubscribeInvalidation(context.Background())
+
+	// 商品搜索索引初始化，后台从MySQL重建
+	err = goods.InitSearch(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	// 商品图片和视频的存储
+	err = blob.Init(config.Conf.MediaConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化snowflake，用于生成商品id
+	err = snowflake.Init(config.Conf.StartTime, int64(config.Conf.MachineId))
+	if err != nil {
+		panic(err)
+	}
+
 	// consul 初始化
 	err = registry.Init(config.Conf.ConsulConfig.Address)
 	if err != nil {
 		panic(err)
 	}
 
+	// 登录令牌和服务间调用令牌的密钥，要在调用其他服务之前设置
+	err = auth.Init(config.Conf.AuthConfig.Secret)
+	if err != nil {
+		panic(err)
+	}
+	err = auth.InitService(config.Conf.AuthConfig.ServiceSecret)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化库存服务的客户端
+	err = rpc.InitSrvClient()
+	if err != nil {
+		panic(err)
+	}
+
+	// 定时上下架和定时调价
+	goods.StartScheduler(context.Background(), config.Conf.ScheduleConfig)
+
+	// 订阅订单支付成功的消息，累计销量
+	c, err := rocketmq.NewPushConsumer(
+		consumer.WithGroupName(config.Conf.RocketMqConfig.GroupId),
+		consumer.WithNsResolver(primitive.NewPassthroughResolver([]string{config.Conf.RocketMqConfig.Addr})),
+	)
+	if err != nil {
+		panic(err)
+	}
+	err = c.Subscribe(config.Conf.RocketMqConfig.Topic.OrderPaid, consumer.MessageSelector{}, handler.OrderPaidHandle)
+	if err != nil {
+		panic(err)
+	}
+	err = c.Start()
+	if err != nil {
+		panic(err)
+	}
+
 	// 监听端口
 	// 不写127.0.0.1，只写端口号，不然外部访问不到你这个rpc服务，同时consul也就无法执行健康检查
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Conf.RpcPort))
@@ -66,7 +147,10 @@ func main() {
 	}
 
 	// 创建gRPC服务
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryAuth(middleware.Policy)),
+		grpc.ChainStreamInterceptor(auth.StreamAuth(middleware.Policy)),
+	)
 	// 注册健康检查服务，至此consul来对我进行检查
 	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
 	// 商品服务注册RPC服务
@@ -101,9 +185,18 @@ func main() {
 		zap.L().Fatal("Failed to register gatewary:", zap.Error(err))
 	}
 
+	// SSE推送直播间商品变化，其余请求交给网关
+	mux := http.NewServeMux()
+	mux.Handle("/v1/room/events", handler.RoomEventsHandler(proto.NewGoodsClient(conn)))
+	// 本地存储的媒体文件由网关直接提供访问，只读文件不列目录
+	if config.Conf.MediaConfig.Backend == "local" {
+		mux.Handle("/media/", http.StripPrefix("/media/", handler.MediaFileHandler(config.Conf.MediaConfig.Dir)))
+	}
+	mux.Handle("/", gwmux)
+
 	gwServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Conf.HttpPort),
-		Handler: gwmux,
+		Handler: mux,
 	}
 	zap.L().Sugar().Infof("Serving gRPC-GateWay on http: 0.0.0.0%s", gwServer.Addr)
 
@@ -115,11 +208,27 @@ func main() {
 		return
 	}()
 
+	// 本地缓存命中率等运行指标只在本机的管理端口提供，不经过网关对外暴露
+	if config.Conf.AdminPort > 0 {
+		adminMux := http.NewServeMux()
+		adminMux.Handle("/debug/vars", expvar.Handler())
+		adminServer := &http.Server{
+			Addr:    fmt.Sprintf("127.0.0.1:%d", config.Conf.AdminPort),
+			Handler: adminMux,
+		}
+		go func() {
+			if err := adminServer.ListenAndServe(); err != nil {
+				zap.L().Info("adminServer.ListenAndServe failed, err:", zap.Error(err))
+			}
+		}()
+	}
+
 	// 服务退出时要注销服务
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
 	// 退出服务时注销服务
 	serviceId := fmt.Sprintf("%s-%s-%d", config.Conf.Name, config.Conf.Ip, config.Conf.RpcPort)
 	registry.Reg.Deregister(serviceId)
+	c.Shutdown()
 }
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"auth"
+	"good_service/proto"
+)
+
+// Policy 商品服务各方法的访问规则，新增方法时需要在这里声明，未声明的方法一律拒绝
+var Policy = auth.Policy{
+	// 买家浏览
+	proto.Goods_GetGoodsByRoom_FullMethodName:      auth.Public,
+	proto.Goods_GetRoomSalesRank_FullMethodName:    auth.Public,
+	proto.Goods_GetGoodsDetail_FullMethodName:      auth.Public,
+	proto.Goods_BatchGetGoodsDetail_FullMethodName: auth.Public,
+	proto.Goods_SearchGoods_FullMethodName:         auth.Public,
+	proto.Goods_CategoryTree_FullMethodName:        auth.Public,
+	proto.Goods_ListBrand_FullMethodName:           auth.Public,
+	proto.Goods_GetCategoryAttrs_FullMethodName:    auth.Public,
+	proto.Goods_ListReviews_FullMethodName:         auth.Public,
+	proto.Goods_WatchRoom_FullMethodName:           auth.Public,
+	// 评价图片和商品图片都通过上传媒体
+	proto.Goods_UploadMedia_FullMethodName: auth.Login,
+
+	// 商家管理商品
+	proto.Goods_CreateGoods_FullMethodName:     auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_UpdateGoods_FullMethodName:     auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_DeleteGoods_FullMethodName:     auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_ListGoods_FullMethodName:       auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_SetGoodsSku_FullMethodName:     auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_SchedulePrice_FullMethodName:   auth.RequireRoles(auth.RoleMerchant),
+	proto.Goods_GetPriceHistory_FullMethodName: auth.RequireRoles(auth.RoleMerchant),
+
+	// 直播间运营
+	proto.Goods_AddRoomGoods_FullMethodName:    auth.RequireRoles(auth.RoleRoomOperator),
+	proto.Goods_RemoveRoomGoods_FullMethodName: auth.RequireRoles(auth.RoleRoomOperator),
+	proto.Goods_SortRoomGoods_FullMethodName:   auth.RequireRoles(auth.RoleRoomOperator),
+	proto.Goods_SetCurrentGoods_FullMethodName: auth.RequireRoles(auth.RoleRoomOperator),
+	proto.Goods_CreateFlashSale_FullMethodName: auth.RequireRoles(auth.RoleRoomOperator, auth.RoleMerchant),
+
+	// 平台管理
+	proto.Goods_CreateCategory_FullMethodName:   auth.RequireRoles(auth.RoleAdmin),
+	proto.Goods_UpdateCategory_FullMethodName:   auth.RequireRoles(auth.RoleAdmin),
+	proto.Goods_SetCategoryAttrs_FullMethodName: auth.RequireRoles(auth.RoleAdmin),
+	proto.Goods_CreateBrand_FullMethodName:      auth.RequireRoles(auth.RoleAdmin),
+	proto.Goods_UpdateBrand_FullMethodName:      auth.RequireRoles(auth.RoleAdmin),
+	proto.Goods_ModerateReview_FullMethodName:   auth.RequireRoles(auth.RoleAdmin),
+
+	// 订单服务校验订单后代为创建评价
+	proto.Goods_CreateReview_FullMethodName: auth.Internal,
+}
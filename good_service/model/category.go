@@ -0,0 +1,54 @@
+package model
+
+// Category 商品类目，Path为从根类目到自身的id路径，如/1/5/12/，用于查询子类目
+type Category struct {
+	BaseMode
+
+	ParentId int64
+	Name     string
+	Level    int
+	Path     string
+	Sort     int
+	Status   int8
+}
+
+func (Category) TableName() string {
+	return "xx_category"
+}
+
+// Brand 品牌
+type Brand struct {
+	BaseMode
+
+	Name   string
+	Logo   string
+	Status int8
+}
+
+func (Brand) TableName() string {
+	return "xx_brand"
+}
+
+// 类目属性的类型
+const (
+	AttrString = "string"
+	AttrNumber = "number"
+	AttrBool   = "bool"
+	AttrEnum   = "enum"
+)
+
+// CategoryAttr 类目属性，商品的ExtJson按所在类目及上级类目的属性校验
+type CategoryAttr struct {
+	BaseMode
+
+	CategoryId int64
+	Name       string
+	Type       string
+	Required   int8
+	Options    string // enum的可选值，json数组
+	Sort       int
+}
+
+func (CategoryAttr) TableName() string {
+	return "xx_category_attr"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// FlashSale 直播间商品秒杀场次
+type FlashSale struct {
+	BaseMode
+
+	RoomId    int64
+	GoodsId   int64
+	Price     int64 // 秒杀价（分）
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (FlashSale) TableName() string {
+	return "xx_flash_sale"
+}
@@ -1,12 +1,14 @@
 package model
 
+import "time"
+
 type Goods struct {
 	BaseMode
 
 	GoodsId     int64
 	CategoryId  int64
 	BrandName   string
-	Code        int64
+	Code        string
 	Status      int8
 	Title       string
 	MarketPrice int64
@@ -14,8 +16,15 @@ type Goods struct {
 	Brief       string
 	HeadImgs    string
 	Videos      string
+	HeadImgIds  string // 头图的媒体id，json数组，非空时代替HeadImgs
+	VideoIds    string // 视频的媒体id，json数组，非空时代替Videos
 	Detail      string
 	ExtJson     string
+	OnShelfAt   *time.Time // 定时上架时间，到期上架后清空
+	OffShelfAt  *time.Time // 定时下架时间，到期下架后清空
+	ReviewCount int64      // 审核通过的评价数，审核评价时更新
+	RatingSum   int64      // 审核通过的评价的评分之和
+	Sales       int64      // 已支付的销量，订单支付消息累计
 }
 
 func (Goods) TableName() string {
@@ -0,0 +1,25 @@
+package model
+
+// 媒体类型
+const (
+	MediaImage int8 = 1
+	MediaVideo int8 = 2
+)
+
+// Media 上传的商品图片和视频，文件存在对象存储中，图片另外生成一张缩略图
+type Media struct {
+	BaseMode
+
+	MediaId     int64
+	Kind        int8
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	ObjectKey   string
+	ThumbKey    string
+}
+
+func (Media) TableName() string {
+	return "xx_media"
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// 价格变更状态
+const (
+	PriceScheduled = iota // 待生效
+	PriceApplied          // 已生效
+	PriceCanceled         // 已取消，如规格已被删除
+)
+
+// GoodsPrice 商品或规格的价格变更记录，定时调价在生效前为待生效状态
+type GoodsPrice struct {
+	BaseMode
+
+	GoodsId     int64
+	SkuId       int64 // 0为商品价格
+	MarketPrice int64
+	Price       int64
+	EffectiveAt time.Time
+	Status      int8
+}
+
+func (GoodsPrice) TableName() string {
+	return "xx_goods_price"
+}
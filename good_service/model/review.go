@@ -0,0 +1,27 @@
+package model
+
+// 评价审核状态
+const (
+	ReviewPending  int8 = 0
+	ReviewApproved int8 = 1
+	ReviewRejected int8 = 2
+)
+
+// GoodsReview 商品评价，一个订单行只能评价一次，审核通过后计入商品评分
+type GoodsReview struct {
+	BaseMode
+
+	ReviewId int64
+	OrderId  int64
+	GoodsId  int64
+	SkuId    int64
+	UserId   int64
+	Rating   int8
+	Content  string
+	ImageIds string // 图片媒体id，json数组
+	Status   int8
+}
+
+func (GoodsReview) TableName() string {
+	return "xx_goods_review"
+}
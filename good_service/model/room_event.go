@@ -0,0 +1,21 @@
+package model
+
+// 直播间商品变化事件类型
+const (
+	RoomEventSnapshot = iota // 订阅时推送的当前状态
+	RoomEventCurrent         // 切换讲解商品
+	RoomEventAdd             // 挂载商品
+	RoomEventRemove          // 移除商品
+	RoomEventSort            // 商品排序
+	RoomEventShelf           // 商品定时上下架
+)
+
+// RoomEvent 直播间商品变化事件，通过redis发布订阅在服务实例间广播
+type RoomEvent struct {
+	RoomId         int64   `json:"room_id"`
+	Type           int32   `json:"type"`
+	CurrentGoodsId int64   `json:"current_goods_id"`
+	GoodsIds       []int64 `json:"goods_ids"`
+	ChangedIds     []int64 `json:"changed_ids"`
+	Timestamp      int64   `json:"timestamp"`
+}
@@ -7,6 +7,7 @@ type RoomGoods struct {
 	GoodsId   int64
 	Weight    int64
 	IsCurrent int8
+	Sales     int64 // 在该直播间的销量，实时排行以redis为准，这里用于重建排行
 }
 
 func (RoomGoods) TableName() string {
@@ -0,0 +1,26 @@
+package model
+
+// OrderPaidInfo 订单服务发送的订单支付成功消息
+type OrderPaidInfo struct {
+	OrderId int64
+	UserId  int64
+	Lines   []*OrderPaidLine
+}
+
+type OrderPaidLine struct {
+	GoodsId int64
+	SkuId   int64
+	RoomId  int64
+	Num     int64
+}
+
+// GoodsSalesLog 已累计销量的订单，消息重复投递时跳过
+type GoodsSalesLog struct {
+	BaseMode
+
+	OrderId int64
+}
+
+func (GoodsSalesLog) TableName() string {
+	return "xx_goods_sales_log"
+}
@@ -0,0 +1,33 @@
+package model
+
+// GoodsSpec 商品的规格项，如颜色、尺码
+type GoodsSpec struct {
+	BaseMode
+
+	GoodsId    int64
+	Name       string
+	SpecValues string // 可选值，json数组
+	Sort       int
+}
+
+func (GoodsSpec) TableName() string {
+	return "xx_goods_spec"
+}
+
+// GoodsSku 商品规格，每个规格有自己的价格和库存
+type GoodsSku struct {
+	BaseMode
+
+	SkuId       int64
+	GoodsId     int64
+	Specs       string // 规格项的取值，json对象，如{"颜色":"红","尺码":"M"}
+	Code        string
+	Image       string
+	MarketPrice int64
+	Price       int64
+	Status      int8
+}
+
+func (GoodsSku) TableName() string {
+	return "xx_goods_sku"
+}
@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"auth"
+	"errors"
+	"fmt"
+	"good_service/config"
+	"good_service/proto"
+
+	_ "github.com/mbobakov/grpc-consul-resolver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// 初始化其他服务的RPC客户端
+
+var StoreCli proto.StoreClient // 库存服务，查询规格的可用库存
+
+func InitSrvClient() error {
+	if len(config.Conf.StoreService.Name) == 0 {
+		return errors.New("invalid StoreService.Name")
+	}
+	// consul实现服务发现
+	stockConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s/%s?wait=14s", config.Conf.ConsulConfig.Address, config.Conf.StoreService.Name),
+		// 指定round_robin策略
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// 携带本服务的令牌，对方据此放行内部接口
+		grpc.WithChainUnaryInterceptor(auth.UnaryClientAuth(config.Conf.Name)),
+		grpc.WithChainStreamInterceptor(auth.StreamClientAuth(config.Conf.Name)),
+	)
+	if err != nil {
+		return fmt.Errorf("dial stock_srv failed: %w", err)
+	}
+	StoreCli = proto.NewStoreClient(stockConn)
+	return nil
+}
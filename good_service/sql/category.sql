@@ -0,0 +1,54 @@
+CREATE TABLE `xx_category` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键/类目id',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `parent_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '父类目id，0为一级类目',
+                              `name` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '类目名',
+                              `level` tinyint(4) UNSIGNED NOT NULL DEFAULT '1' COMMENT '层级',
+                              `path` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'id路径，如/1/5/12/',
+                              `sort` INT(11) NOT NULL DEFAULT '0' COMMENT '排序',
+                              `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：0启用1停用',
+                              UNIQUE (parent_id, name),
+                              INDEX (path),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品类目表';
+
+CREATE TABLE `xx_brand` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键/品牌id',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '品牌名，与商品的brand_name对应',
+                              `logo` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '品牌logo',
+                              `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：0启用1停用',
+                              UNIQUE (name),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '品牌表';
+
+CREATE TABLE `xx_category_attr` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `category_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '类目id，子类目继承上级类目的属性',
+                              `name` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '属性名，即ext_json中的key，如material',
+                              `type` VARCHAR(16) NOT NULL DEFAULT '' COMMENT '类型：string number bool enum',
+                              `required` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否必填：0否1是',
+                              `options` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT 'enum的可选值，json数组',
+                              `sort` INT(11) NOT NULL DEFAULT '0' COMMENT '排序',
+                              UNIQUE (category_id, name),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '类目属性表';
@@ -18,9 +18,18 @@ CREATE TABLE `xx_goods` (
                          `brief` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '简介',
                          `head_imgs` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '头图',
                          `videos` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '视频介绍',
+                         `head_img_ids` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '头图媒体id',
+                         `video_ids` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '视频媒体id',
                          `detail` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '详情',
                          `ext_json` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '扩展字段',
+                         `on_shelf_at` DATETIME NULL DEFAULT NULL COMMENT '定时上架时间',
+                         `off_shelf_at` DATETIME NULL DEFAULT NULL COMMENT '定时下架时间',
+                         `review_count` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '审核通过的评价数',
+                         `rating_sum` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '审核通过的评价评分之和',
+                         `sales` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '销量',
                          UNIQUE (goods_id),
                          INDEX (category_id),
+                         INDEX (on_shelf_at),
+                         INDEX (off_shelf_at),
                          INDEX (is_del)
 )ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品表';
\ No newline at end of file
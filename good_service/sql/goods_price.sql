@@ -0,0 +1,19 @@
+CREATE TABLE `xx_goods_price` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品id',
+                              `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id，0为商品价格',
+                              `market_price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '市场价/划线价（分）',
+                              `price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '售价（分）',
+                              `effective_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '生效时间',
+                              `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：0待生效 1已生效 2已取消',
+                              INDEX (goods_id, effective_at),
+                              INDEX (status, effective_at),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品价格变更记录表';
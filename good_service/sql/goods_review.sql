@@ -0,0 +1,24 @@
+CREATE TABLE `xx_goods_review` (
+                         `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                         `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                         `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                         `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                         `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                         `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                         `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                         `review_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '评价id',
+                         `order_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '订单id',
+                         `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品id',
+                         `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id，无规格为0',
+                         `user_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '用户id',
+                         `rating` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '评分1-5',
+                         `content` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '评价内容',
+                         `image_ids` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '图片媒体id',
+                         `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '审核状态：0待审核1通过2拒绝',
+                         UNIQUE (review_id),
+                         UNIQUE (order_id, goods_id, sku_id),
+                         INDEX (goods_id, status),
+                         INDEX (status),
+                         INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品评价表';
@@ -0,0 +1,38 @@
+CREATE TABLE `xx_goods_spec` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品id',
+                              `name` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '规格名，如颜色',
+                              `spec_values` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '可选值，json数组',
+                              `sort` INT(11) NOT NULL DEFAULT '0' COMMENT '排序',
+                              INDEX (goods_id),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品规格项表';
+
+CREATE TABLE `xx_goods_sku` (
+                              `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                              `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                              `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                              `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                              `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                              `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                              `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id',
+                              `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品id',
+                              `specs` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '规格项取值，json对象',
+                              `code` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '规格编码',
+                              `image` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '规格图片',
+                              `market_price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '市场价/划线价（分）',
+                              `price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '售价（分）',
+                              `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：0上架1下架',
+                              UNIQUE (sku_id),
+                              INDEX (goods_id),
+                              INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品规格表';
@@ -0,0 +1,20 @@
+CREATE TABLE `xx_media` (
+                         `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                         `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                         `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                         `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                         `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                         `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                         `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                         `media_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '媒体id',
+                         `kind` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '类型：1图片2视频',
+                         `content_type` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '文件类型',
+                         `size` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '文件大小（字节）',
+                         `width` INT(11) UNSIGNED NOT NULL DEFAULT '0' COMMENT '图片宽度',
+                         `height` INT(11) UNSIGNED NOT NULL DEFAULT '0' COMMENT '图片高度',
+                         `object_key` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '文件在对象存储中的key',
+                         `thumb_key` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '缩略图在对象存储中的key',
+                         UNIQUE (media_id),
+                         INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '商品媒体表';
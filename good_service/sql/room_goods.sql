@@ -11,6 +11,7 @@ CREATE TABLE `xx_room_goods` (
                               `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品id',
                               `weight` BIGINT(20) NOT NULL DEFAULT '1000' COMMENT '排序权重',
                               `is_current` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否当前讲解中：0不是1是',
+                              `sales` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '在该直播间的销量',
                               UNIQUE (room_id, goods_id),
                               INDEX (is_del)
 )ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '直播间商品表';
\ No newline at end of file
@@ -0,0 +1,41 @@
+package money
+
+import "strconv"
+
+// Money 金额，以分为单位的整数，所有服务统一使用，避免浮点运算丢失精度
+type Money int64
+
+// FromCent 由分构造金额
+func FromCent(cent int64) Money {
+	return Money(cent)
+}
+
+// Cent 金额的分值
+func (m Money) Cent() int64 {
+	return int64(m)
+}
+
+// String 以元为单位展示，保留两位小数
+func (m Money) String() string {
+	c := int64(m)
+	sign := ""
+	if c < 0 {
+		sign = "-"
+		c = -c
+	}
+	frac := strconv.FormatInt(c%100, 10)
+	if len(frac) < 2 {
+		frac = "0" + frac
+	}
+	return sign + strconv.FormatInt(c/100, 10) + "." + frac
+}
+
+// Mul 金额乘以数量
+func (m Money) Mul(n int64) Money {
+	return m * Money(n)
+}
+
+// Percent 金额乘以百分比，不足一分的部分舍去
+func (m Money) Percent(p int64) Money {
+	return m * Money(p) / 100
+}
@@ -0,0 +1,71 @@
+package money
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		cent int64
+		want string
+	}{
+		{0, "0.00"},
+		{1, "0.01"},
+		{10, "0.10"},
+		{99, "0.99"},
+		{100, "1.00"},
+		{1234, "12.34"},
+		{-1, "-0.01"},
+		{-1050, "-10.50"},
+	}
+	for _, tt := range tests {
+		if got := FromCent(tt.cent).String(); got != tt.want {
+			t.Errorf("FromCent(%d).String() = %q, want %q", tt.cent, got, tt.want)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	// 浮点数0.1+0.2不等于0.3，按分计算没有误差
+	if got := FromCent(10) + FromCent(20); got != FromCent(30) || got.String() != "0.30" {
+		t.Errorf("0.10 + 0.20 = %s, want 0.30", got)
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		cent int64
+		n    int64
+		want int64
+	}{
+		{1999, 3, 5997},
+		{10, 3, 30},
+		{1, 0, 0},
+		{-250, 2, -500},
+	}
+	for _, tt := range tests {
+		if got := FromCent(tt.cent).Mul(tt.n).Cent(); got != tt.want {
+			t.Errorf("FromCent(%d).Mul(%d) = %d, want %d", tt.cent, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		cent int64
+		p    int64
+		want int64
+	}{
+		{1000, 85, 850},
+		// 半分及以下都舍去
+		{1, 50, 0},
+		{3, 50, 1},
+		{999, 15, 149},
+		{101, 50, 50},
+		{-101, 50, -50},
+		{1000, 100, 1000},
+	}
+	for _, tt := range tests {
+		if got := FromCent(tt.cent).Percent(tt.p).Cent(); got != tt.want {
+			t.Errorf("FromCent(%d).Percent(%d) = %d, want %d", tt.cent, tt.p, got, tt.want)
+		}
+	}
+}
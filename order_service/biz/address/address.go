@@ -0,0 +1,148 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+	"order_service/third_party/snowflake"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// 每个用户最多保存的收货地址数
+const maxAddressCount = 20
+
+var (
+	ErrAddressNotFound = mysql.ErrAddressNotFound
+	ErrAddressLimit    = mysql.ErrAddressLimit
+	ErrInvalidName     = errors.New("收货人姓名有误")
+	ErrInvalidPhone    = errors.New("手机号格式有误")
+	ErrInvalidRegion   = errors.New("省市区有误")
+	ErrInvalidDetail   = errors.New("详细地址有误")
+)
+
+// 大陆手机号，收货人电话字段只有11位
+var phoneRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// Create 新增收货地址
+func Create(ctx context.Context, req *proto.AddressInfo) (*proto.AddressInfo, error) {
+	data, err := toAddress(req)
+	if err != nil {
+		return nil, err
+	}
+	data.AddressId = snowflake.GenID()
+	data.CreateBy = data.UpdateBy
+	if err := mysql.CreateAddress(ctx, data, maxAddressCount); err != nil {
+		return nil, err
+	}
+	return toAddressInfo(data), nil
+}
+
+// Update 修改收货地址
+func Update(ctx context.Context, req *proto.AddressInfo) (*proto.AddressInfo, error) {
+	data, err := toAddress(req)
+	if err != nil {
+		return nil, err
+	}
+	data.AddressId = req.GetAddressId()
+	if err := mysql.UpdateAddress(ctx, data); err != nil {
+		return nil, err
+	}
+	return toAddressInfo(data), nil
+}
+
+// Delete 删除收货地址，返回删除后的地址列表
+func Delete(ctx context.Context, userId, addressId int64) (*proto.AddressListResp, error) {
+	if err := mysql.DeleteAddress(ctx, userId, addressId); err != nil {
+		return nil, err
+	}
+	return List(ctx, userId)
+}
+
+// SetDefault 设为默认地址，返回修改后的地址列表
+func SetDefault(ctx context.Context, userId, addressId int64) (*proto.AddressListResp, error) {
+	if err := mysql.SetDefaultAddress(ctx, userId, addressId); err != nil {
+		return nil, err
+	}
+	return List(ctx, userId)
+}
+
+// List 收货地址列表
+func List(ctx context.Context, userId int64) (*proto.AddressListResp, error) {
+	list, err := mysql.GetAddressList(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.AddressListResp{Data: make([]*proto.AddressInfo, 0, len(list))}
+	for _, data := range list {
+		resp.Data = append(resp.Data, toAddressInfo(data))
+	}
+	return resp, nil
+}
+
+// Get 下单时查询用户自己的收货地址
+func Get(ctx context.Context, userId, addressId int64) (*model.Address, error) {
+	return mysql.GetAddress(ctx, userId, addressId)
+}
+
+// FullAddress 省市区和详细地址拼接，作为订单的收货地址
+func FullAddress(data *model.Address) string {
+	// 直辖市的省和市同名，只保留一个
+	if data.Province == data.City {
+		return data.Province + data.District + data.Detail
+	}
+	return data.Province + data.City + data.District + data.Detail
+}
+
+// toAddress 校验并转换请求，首尾空白去掉后再校验长度
+func toAddress(req *proto.AddressInfo) (*model.Address, error) {
+	data := &model.Address{
+		UserId:     req.GetUserId(),
+		Name:       strings.TrimSpace(req.GetName()),
+		Phone:      strings.TrimSpace(req.GetPhone()),
+		RegionCode: strings.TrimSpace(req.GetRegionCode()),
+		Province:   strings.TrimSpace(req.GetProvince()),
+		City:       strings.TrimSpace(req.GetCity()),
+		District:   strings.TrimSpace(req.GetDistrict()),
+		Detail:     strings.TrimSpace(req.GetDetail()),
+		IsDefault:  req.GetIsDefault(),
+	}
+	if n := utf8.RuneCountInString(data.Name); n == 0 || n > 32 {
+		return nil, ErrInvalidName
+	}
+	if !phoneRegexp.MatchString(data.Phone) {
+		return nil, ErrInvalidPhone
+	}
+	if !checkRegion(data.RegionCode, data.Province) {
+		return nil, ErrInvalidRegion
+	}
+	for _, s := range []string{data.City, data.District} {
+		if n := utf8.RuneCountInString(s); n == 0 || n > 32 {
+			return nil, ErrInvalidRegion
+		}
+	}
+	if n := utf8.RuneCountInString(data.Detail); n == 0 || n > 100 {
+		return nil, ErrInvalidDetail
+	}
+	data.UpdateBy = strconv.FormatInt(data.UserId, 10)
+	return data, nil
+}
+
+func toAddressInfo(data *model.Address) *proto.AddressInfo {
+	return &proto.AddressInfo{
+		AddressId:  data.AddressId,
+		UserId:     data.UserId,
+		Name:       data.Name,
+		Phone:      data.Phone,
+		RegionCode: data.RegionCode,
+		Province:   data.Province,
+		City:       data.City,
+		District:   data.District,
+		Detail:     data.Detail,
+		IsDefault:  data.IsDefault,
+	}
+}
@@ -0,0 +1,56 @@
+package address
+
+// 省级行政区划代码，GB/T 2260，区县代码的前两位
+var provinces = map[string]string{
+	"11": "北京市",
+	"12": "天津市",
+	"13": "河北省",
+	"14": "山西省",
+	"15": "内蒙古自治区",
+	"21": "辽宁省",
+	"22": "吉林省",
+	"23": "黑龙江省",
+	"31": "上海市",
+	"32": "江苏省",
+	"33": "浙江省",
+	"34": "安徽省",
+	"35": "福建省",
+	"36": "江西省",
+	"37": "山东省",
+	"41": "河南省",
+	"42": "湖北省",
+	"43": "湖南省",
+	"44": "广东省",
+	"45": "广西壮族自治区",
+	"46": "海南省",
+	"50": "重庆市",
+	"51": "四川省",
+	"52": "贵州省",
+	"53": "云南省",
+	"54": "西藏自治区",
+	"61": "陕西省",
+	"62": "甘肃省",
+	"63": "青海省",
+	"64": "宁夏回族自治区",
+	"65": "新疆维吾尔自治区",
+	"71": "台湾省",
+	"81": "香港特别行政区",
+	"82": "澳门特别行政区",
+}
+
+// checkRegion 区县代码为6位数字，前两位是已知的省且与省名一致，市和区县两段不能为00
+func checkRegion(code, province string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	name, ok := provinces[code[:2]]
+	if !ok || name != province {
+		return false
+	}
+	return code[2:4] != "00" && code[4:] != "00"
+}
@@ -0,0 +1,286 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"money"
+	"order_service/biz/order"
+	"order_service/dao/mysql"
+	"order_service/dao/redis"
+	"order_service/model"
+	"order_service/proto"
+	"order_service/rpc"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrGoodsOffShelf    = order.ErrGoodsOffShelf
+	ErrStockNotEnough   = errors.New("商品库存不足")
+	ErrCartItemNotFound = errors.New("购物车中没有该商品")
+	ErrNothingSelected  = errors.New("没有选中结算的商品")
+)
+
+// getCart 读取用户购物车，优先读Redis，缓存不存在时从MySQL加载并回填
+func getCart(ctx context.Context, userId int64) ([]*model.Cart, error) {
+	data, ok, err := redis.GetCart(ctx, userId)
+	if err != nil {
+		zap.L().Warn("redis.GetCart failed", zap.Int64("user_id", userId), zap.Error(err))
+	}
+	if !ok {
+		data, err = mysql.GetCartList(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+		if err := redis.SetCart(ctx, userId, data); err != nil {
+			zap.L().Warn("redis.SetCart failed", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].ID < data[j].ID })
+	return data, nil
+}
+
+// saveCart 先写MySQL再删除缓存
+func saveCart(ctx context.Context, item *model.Cart) error {
+	if err := mysql.SaveCart(ctx, item); err != nil {
+		return err
+	}
+	return redis.DelCart(ctx, item.UserId)
+}
+
+func findItem(list []*model.Cart, goodsId, skuId int64) *model.Cart {
+	for _, item := range list {
+		if item.GoodsId == goodsId && item.SkuId == skuId {
+			return item
+		}
+	}
+	return nil
+}
+
+// checkGoods 校验商品及规格在架且库存足够
+func checkGoods(ctx context.Context, item *model.Cart) error {
+	detail, err := rpc.GoodsCli.GetGoodsDetail(ctx, &proto.GetGoodsDetailReq{
+		GoodsId: item.GoodsId,
+		UserId:  item.UserId,
+		RoomId:  item.RoomId,
+	})
+	if err != nil {
+		return err
+	}
+	sku, err := order.FindSku(detail, item.SkuId)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if !order.OnSale(detail, sku, now) {
+		return ErrGoodsOffShelf
+	}
+
+	_, sessionId := order.UnitPrice(detail, sku, now)
+	store, err := rpc.StoreCli.GetStore(ctx, &proto.GoodsStoreInfo{
+		GoodsId:   item.GoodsId,
+		SkuId:     item.SkuId,
+		SessionId: sessionId,
+	})
+	if err != nil {
+		return err
+	}
+	if store.GetNum() < item.Num {
+		return ErrStockNotEnough
+	}
+	return nil
+}
+
+// Add 加入购物车，已存在时累加数量
+func Add(ctx context.Context, req *proto.CartItemReq) (*proto.CartListResp, error) {
+	list, err := getCart(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	item := &model.Cart{
+		UserId:   req.GetUserId(),
+		GoodsId:  req.GetGoodsId(),
+		SkuId:    req.GetSkuId(),
+		RoomId:   req.GetRoomId(),
+		Num:      req.GetNum(),
+		Selected: true,
+	}
+	if old := findItem(list, req.GetGoodsId(), req.GetSkuId()); old != nil {
+		item.Num += old.Num
+		if item.RoomId == 0 {
+			item.RoomId = old.RoomId
+		}
+	}
+	if err := checkGoods(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := saveCart(ctx, item); err != nil {
+		return nil, err
+	}
+	return List(ctx, req.GetUserId())
+}
+
+// Update 修改购物车商品的数量和选中状态，不传选中状态时保持不变
+func Update(ctx context.Context, req *proto.CartItemReq) (*proto.CartListResp, error) {
+	list, err := getCart(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	old := findItem(list, req.GetGoodsId(), req.GetSkuId())
+	if old == nil {
+		return nil, ErrCartItemNotFound
+	}
+	item := &model.Cart{
+		UserId:   old.UserId,
+		GoodsId:  old.GoodsId,
+		SkuId:    old.SkuId,
+		RoomId:   old.RoomId,
+		Num:      req.GetNum(),
+		Selected: old.Selected,
+	}
+	if req.Selected != nil {
+		item.Selected = req.GetSelected()
+	}
+	if item.Num != old.Num {
+		if err := checkGoods(ctx, item); err != nil {
+			return nil, err
+		}
+	}
+	if err := saveCart(ctx, item); err != nil {
+		return nil, err
+	}
+	return List(ctx, req.GetUserId())
+}
+
+// Remove 删除购物车商品，goodsIds删除商品的所有规格，skuIds删除指定规格
+func Remove(ctx context.Context, userId int64, goodsIds, skuIds []int64) (*proto.CartListResp, error) {
+	if err := mysql.DeleteCart(ctx, userId, goodsIds, skuIds); err != nil {
+		return nil, err
+	}
+	if err := redis.DelCart(ctx, userId); err != nil {
+		return nil, err
+	}
+	return List(ctx, userId)
+}
+
+// List 购物车列表，带上商品的当前价格和可用库存
+func List(ctx context.Context, userId int64) (*proto.CartListResp, error) {
+	list, err := getCart(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*proto.GoodsDetailKey, 0, len(list))
+	for _, item := range list {
+		keys = append(keys, &proto.GoodsDetailKey{GoodsId: item.GoodsId, RoomId: item.RoomId})
+	}
+	details, err := order.GoodsDetails(ctx, userId, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now      = time.Now()
+		data     = make([]*proto.CartItemInfo, 0, len(list))
+		storeReq = make([]*proto.GoodsStoreInfo, 0, len(list))
+		prices   = make([]money.Money, 0, len(list))
+	)
+	for i, item := range list {
+		// 商品或规格已被删除时按下架展示
+		detail := details[i]
+		sku, skuErr := order.FindSku(detail, item.SkuId)
+		price, sessionId := order.UnitPrice(detail, sku, now)
+		info := &proto.CartItemInfo{
+			GoodsId:   item.GoodsId,
+			SkuId:     item.SkuId,
+			SpecText:  sku.GetSpecText(),
+			RoomId:    item.RoomId,
+			Num:       item.Num,
+			Selected:  item.Selected,
+			Title:     detail.GetTitle(),
+			Price:     price.String(),
+			PriceCent: price.Cent(),
+			Status:    detail.GetStatus(),
+		}
+		if detail == nil || skuErr != nil || !order.OnSale(detail, sku, now) {
+			info.Status = 1
+		}
+		if len(sku.GetImage()) > 0 {
+			info.HeadImg = sku.GetImage()
+		} else if len(detail.GetHeadImgs()) > 0 {
+			info.HeadImg = detail.GetHeadImgs()[0]
+		}
+		data = append(data, info)
+		prices = append(prices, price)
+		storeReq = append(storeReq, &proto.GoodsStoreInfo{GoodsId: item.GoodsId, SkuId: item.SkuId, SessionId: sessionId})
+	}
+
+	resp := &proto.CartListResp{Data: data}
+	if len(storeReq) == 0 {
+		return resp, nil
+	}
+	stores, err := rpc.StoreCli.BatchGetStore(ctx, &proto.GoodsListStore{Data: storeReq})
+	if err != nil {
+		return nil, err
+	}
+	var selected money.Money
+	for i, info := range data {
+		if i < len(stores.GetData()) {
+			info.Stock = stores.GetData()[i].GetNum()
+		}
+		if info.Selected {
+			selected += prices[i].Mul(info.Num)
+		}
+	}
+	resp.SelectedAmount = selected.Cent()
+	return resp, nil
+}
+
+// Checkout 选中的购物车商品生成一个订单，下单成功后从购物车移除
+func Checkout(ctx context.Context, req *proto.CheckoutReq) (*proto.OrderBaseResp, error) {
+	list, err := getCart(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		items            []*proto.OrderItem
+		goodsIds, skuIds []int64
+	)
+	for _, item := range list {
+		if !item.Selected {
+			continue
+		}
+		items = append(items, &proto.OrderItem{
+			GoodsId: item.GoodsId,
+			Num:     item.Num,
+			RoomId:  item.RoomId,
+			SkuId:   item.SkuId,
+		})
+		// 有规格的只移除下单的规格
+		if item.SkuId > 0 {
+			skuIds = append(skuIds, item.SkuId)
+		} else {
+			goodsIds = append(goodsIds, item.GoodsId)
+		}
+	}
+	if len(items) == 0 {
+		return nil, ErrNothingSelected
+	}
+
+	resp, err := order.Create(ctx, &proto.OrderReq{
+		UserId:    req.GetUserId(),
+		AddressId: req.GetAddressId(),
+		CouponId:  req.GetCouponId(),
+		Items:     items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := Remove(ctx, req.GetUserId(), goodsIds, skuIds); err != nil {
+		zap.L().Error("remove checkout cart items failed", zap.Int64("order_id", resp.GetOrderId()), zap.Error(err))
+	}
+	return resp, nil
+}
@@ -0,0 +1,197 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"money"
+	"order_service/proto"
+	"order_service/rpc"
+	"time"
+)
+
+var (
+	ErrSkuRequired   = errors.New("请选择商品规格")
+	ErrSkuNotFound   = errors.New("商品规格不存在")
+	ErrGoodsOffShelf = errors.New("商品已下架")
+	ErrGoodsNotFound = errors.New("商品不存在")
+)
+
+// orderLine 订单商品行，成交价在下单时由商品服务确定
+type orderLine struct {
+	GoodsId   int64
+	SkuId     int64
+	RoomId    int64
+	Num       int64
+	SessionId int64       // 秒杀场次id，0表示按普通售价
+	Price     money.Money // 成交单价
+	Amount    money.Money // 行金额
+	Detail    *proto.GoodsDetail
+	Sku       *proto.SkuInfo // 没有规格的商品为nil
+}
+
+// Lines 取出下单的商品行，items为空时兼容单商品下单
+// 存在无效或重复的商品行时返回nil，同一商品的不同规格是不同的行
+func Lines(req *proto.OrderReq) []*proto.OrderItem {
+	items := req.GetItems()
+	if len(items) == 0 {
+		items = []*proto.OrderItem{{
+			GoodsId: req.GetGoodsId(),
+			Num:     req.GetNum(),
+			RoomId:  req.GetRoomId(),
+			SkuId:   req.GetSkuId(),
+		}}
+	}
+	type lineKey struct{ goodsId, skuId int64 }
+	seen := make(map[lineKey]struct{}, len(items))
+	for _, item := range items {
+		if item.GetGoodsId() <= 0 || item.GetNum() <= 0 || item.GetSkuId() < 0 {
+			return nil
+		}
+		key := lineKey{goodsId: item.GetGoodsId(), skuId: item.GetSkuId()}
+		if _, ok := seen[key]; ok {
+			return nil
+		}
+		seen[key] = struct{}{}
+	}
+	return items
+}
+
+// priceLines 查询商品详情确定每行的成交价，返回商品行和订单总金额
+func priceLines(ctx context.Context, userId int64, items []*proto.OrderItem, now time.Time) ([]*orderLine, money.Money, error) {
+	keys := make([]*proto.GoodsDetailKey, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, &proto.GoodsDetailKey{GoodsId: item.GetGoodsId(), RoomId: item.GetRoomId()})
+	}
+	details, err := GoodsDetails(ctx, userId, keys)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total money.Money
+	lines := make([]*orderLine, 0, len(items))
+	for i, item := range items {
+		detail := details[i]
+		if detail == nil {
+			return nil, 0, ErrGoodsNotFound
+		}
+
+		sku, err := FindSku(detail, item.GetSkuId())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		line := &orderLine{
+			GoodsId: item.GetGoodsId(),
+			SkuId:   item.GetSkuId(),
+			RoomId:  item.GetRoomId(),
+			Num:     item.GetNum(),
+			Detail:  detail,
+			Sku:     sku,
+		}
+		line.Price, line.SessionId = UnitPrice(detail, sku, now)
+		line.Amount = line.Price.Mul(line.Num)
+		total += line.Amount
+		lines = append(lines, line)
+	}
+	return lines, total, nil
+}
+
+// GoodsDetails 一次批量查询商品详情，结果与keys一一对应，不存在的商品为nil
+func GoodsDetails(ctx context.Context, userId int64, keys []*proto.GoodsDetailKey) ([]*proto.GoodsDetail, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	resp, err := rpc.GoodsCli.BatchGetGoodsDetail(ctx, &proto.BatchGetGoodsDetailReq{
+		Items:  keys,
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetData()) != len(keys) {
+		return nil, errors.New("商品详情数量与请求不一致")
+	}
+	details := make([]*proto.GoodsDetail, len(keys))
+	for i, item := range resp.GetData() {
+		if !item.GetNotFound() {
+			details[i] = item.GetDetail()
+		}
+	}
+	return details, nil
+}
+
+// FindSku 找到下单的规格，有规格的商品必须指定规格，没有规格的商品返回nil
+func FindSku(detail *proto.GoodsDetail, skuId int64) (*proto.SkuInfo, error) {
+	if len(detail.GetSkus()) == 0 {
+		if skuId > 0 {
+			return nil, ErrSkuNotFound
+		}
+		return nil, nil
+	}
+	if skuId <= 0 {
+		return nil, ErrSkuRequired
+	}
+	for _, sku := range detail.GetSkus() {
+		if sku.GetSkuId() == skuId {
+			return sku, nil
+		}
+	}
+	return nil, ErrSkuNotFound
+}
+
+// OnSale 商品和下单的规格都在架
+// 商品服务定时扫描修改上下架状态，定时下架时间已到但状态还未修改时同样按下架处理
+func OnSale(detail *proto.GoodsDetail, sku *proto.SkuInfo, now time.Time) bool {
+	if off := detail.GetOffShelfAt(); off > 0 && now.Unix() >= off {
+		return false
+	}
+	return detail.GetStatus() == 0 && (sku == nil || sku.GetStatus() == 0)
+}
+
+// checkOnSale 下单的商品行都必须在架
+func checkOnSale(lines []*orderLine, now time.Time) error {
+	for _, line := range lines {
+		if !OnSale(line.Detail, line.Sku, now) {
+			return ErrGoodsOffShelf
+		}
+	}
+	return nil
+}
+
+// UnitPrice 商品在指定时间的成交单价，有规格时按规格的售价
+// 秒杀场次进行中时所有规格都按秒杀价下单，并返回场次id用于扣减场次的限量库存
+// 定时调价已到生效时间时按调价后的价格，不依赖商品服务的定时任务是否已执行
+func UnitPrice(detail *proto.GoodsDetail, sku *proto.SkuInfo, now time.Time) (money.Money, int64) {
+	if fs := detail.GetFlashSale(); inFlashSale(fs, now) {
+		return money.FromCent(fs.PriceCent), fs.SessionId
+	}
+	if p := effectivePrice(detail.GetPricePlan(), sku.GetSkuId(), now); p != nil {
+		return money.FromCent(p.GetPriceCent()), 0
+	}
+	if sku != nil {
+		return money.FromCent(sku.GetPriceCent()), 0
+	}
+	return money.FromCent(detail.GetPriceCent()), 0
+}
+
+// effectivePrice 找到商品或规格在指定时间已生效的最后一次定时调价
+func effectivePrice(plan []*proto.PriceChangeInfo, skuId int64, now time.Time) *proto.PriceChangeInfo {
+	var res *proto.PriceChangeInfo
+	for _, p := range plan {
+		if p.GetSkuId() != skuId || p.GetEffectiveAt() > now.Unix() {
+			continue
+		}
+		if res == nil || p.GetEffectiveAt() >= res.GetEffectiveAt() {
+			res = p
+		}
+	}
+	return res
+}
+
+// inFlashSale 判断秒杀场次在指定时间是否处于进行中
+func inFlashSale(fs *proto.FlashSaleInfo, now time.Time) bool {
+	if fs == nil || fs.SessionId <= 0 {
+		return false
+	}
+	return now.Unix() >= fs.StartTime && now.Unix() < fs.EndTime
+}
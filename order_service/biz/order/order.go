@@ -3,7 +3,10 @@ package order
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"order_service/biz/address"
+	"order_service/biz/promotion"
 	"order_service/config"
 	"order_service/dao/mq"
 	"order_service/dao/mysql"
@@ -11,7 +14,7 @@ import (
 	"order_service/proto"
 	"order_service/rpc"
 	"order_service/third_party/snowflake"
-	"strconv"
+	"time"
 
 	"github.com/apache/rocketmq-client-go/v2"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
@@ -25,6 +28,7 @@ import (
 type OrderMessageEntity struct {
 	OrderId int64
 	Param   *proto.OrderReq
+	Address *model.Address // 下单前查出的收货地址，作为快照保存到订单
 	err     error
 }
 
@@ -44,72 +48,105 @@ func (o *OrderMessageEntity) ExecuteLocalTransaction(*primitive.Message) primiti
 	fmt.Println("Start ExecuteLocalTransaction...")
 	// 检查参数
 	// 参数错误，此时库存还未扣减，则丢弃回滚库存的消息，所以回复rollback，消息被丢弃
-	if o.Param == nil {
+	if o.Param == nil || o.Address == nil {
 		zap.L().Error("ExecuteLocalTransation param is nil")
 		o.err = status.Error(codes.Internal, "invalid OrderMessageEntity")
 		return primitive.RollbackMessageState
 	}
 	params := o.Param
 	ctx := context.Background()
-	// 查询商品详情，此时也还没扣减库存，如果出错，则丢弃回滚库存的消息，所以回复rollback，消息被丢弃
-	goodsDetail, err := rpc.GoodsCli.GetGoodsDetail(ctx, &proto.GetGoodsDetailReq{
-		GoodsId: params.GoodsId,
-		UserId:  params.UserId,
-	})
-	if err != nil {
-		zap.L().Error("rpc.GoodsCli.GetGoodsDetail failed", zap.Error(err))
-		o.err = status.Error(codes.Internal, err.Error())
+	// 查询商品详情并计算优惠和运费，此时也还没扣减库存，如果出错，则丢弃回滚库存的消息，所以回复rollback，消息被丢弃
+	now := time.Now()
+	q, err := buildQuote(ctx, params, now)
+	if errors.Is(err, promotion.ErrCouponUnavailable) || errors.Is(err, ErrSkuRequired) || errors.Is(err, ErrSkuNotFound) || errors.Is(err, ErrGoodsNotFound) {
+		o.err = status.Error(codes.InvalidArgument, err.Error())
 		return primitive.RollbackMessageState
 	}
-
-	payAmountStr := goodsDetail.Price
-	payAmount, _ := strconv.ParseFloat(payAmountStr, 64)
-	payAmount *= float64(params.Num)
-	// 扣减库存，此时库存也还没完成扣减，如果出错，同样丢弃回滚库存的消息，所以回复rollback，消息丢弃
-	_, err = rpc.StoreCli.ReduceStore(ctx, &proto.GoodsStoreInfo{
-		GoodsId: params.GoodsId,
-		Num:     params.Num,
-		OrderId: o.OrderId,
-	})
 	if err != nil {
-		zap.L().Error("rpc.StoreCli.ReduceStore failed", zap.Error(err))
+		zap.L().Error("buildQuote failed", zap.Int64("coupon_id", params.CouponId), zap.Error(err))
 		o.err = status.Error(codes.Internal, err.Error())
 		return primitive.RollbackMessageState
 	}
+	// 已下架的商品不能下单
+	if err := checkOnSale(q.Lines, now); err != nil {
+		o.err = status.Error(codes.FailedPrecondition, err.Error())
+		return primitive.RollbackMessageState
+	}
+	// 携带报价凭证时按预览时展示的金额下单
+	if len(params.QuoteToken) > 0 {
+		err := q.honorQuote(ctx, params, now)
+		if errors.Is(err, ErrInvalidQuote) {
+			o.err = status.Error(codes.InvalidArgument, err.Error())
+			return primitive.RollbackMessageState
+		}
+		if err != nil {
+			zap.L().Error("honorQuote failed", zap.Int64("order_id", o.OrderId), zap.Error(err))
+			o.err = status.Error(codes.Internal, err.Error())
+			return primitive.RollbackMessageState
+		}
+	}
+	lines, promo := q.Lines, q.Promo
+	discountDetail, _ := json.Marshal(promo.Items)
+	// 逐行扣减库存
+	// 第一行扣减失败时库存还没有扣减，同样丢弃回滚库存的消息，所以回复rollback，消息丢弃
+	// 之后的行扣减失败时前面的行已经扣减，需要回复commit回滚整单已扣减的库存
+	for i, line := range lines {
+		_, err = rpc.StoreCli.ReduceStore(ctx, &proto.GoodsStoreInfo{
+			GoodsId:   line.GoodsId,
+			SkuId:     line.SkuId,
+			Num:       line.Num,
+			OrderId:   o.OrderId,
+			SessionId: line.SessionId,
+		})
+		if err != nil {
+			zap.L().Error("rpc.StoreCli.ReduceStore failed", zap.Int64("goods_id", line.GoodsId), zap.Error(err))
+			o.err = status.Error(codes.Internal, err.Error())
+			if i == 0 {
+				return primitive.RollbackMessageState
+			}
+			return primitive.CommitMessageState
+		}
+	}
 
 	orderData := model.Order{
 		OrderId:        o.OrderId,
 		UserId:         params.UserId,
-		PayAmount:      int64(payAmount),
-		ReceiveAddress: params.Address,
-		ReceiveName:    params.Name,
-		ReceivePhone:   params.Phone,
-		Status:         100,
-	}
+		PayAmount:      q.PayAmount.Cent(),
+		OriginalAmount: promo.OriginalAmount,
+		DiscountAmount: promo.DiscountAmount,
+		DiscountDetail: string(discountDetail),
+		CouponId:       promo.CouponId,
+		ShippingFee:    q.ShippingFee.Cent(),
+		Status:         model.OrderStatusUnpaid,
 
-	orderDetail := model.OrderDetail{
-		OrderId: o.OrderId,
-		UserId:  params.UserId,
-		GoodsId: params.GoodsId,
-		Num:     params.Num,
+		AddressId:         o.Address.AddressId,
+		ReceiveAddress:    address.FullAddress(o.Address),
+		ReceiveName:       o.Address.Name,
+		ReceivePhone:      o.Address.Phone,
+		ReceiveRegionCode: o.Address.RegionCode,
 	}
+
+	// 保存下单时的商品快照
+	orderDetails := snapshotDetails(o.OrderId, params.UserId, lines, promo.PayAmount)
 	// 创建订单
 	// 此时库存已经扣减，如果再出错，就需要回滚库存了，需要向RocketMQ回复commit，使消息被真正投递出去
-	err = mysql.CreateOrderWithTransation(ctx, &orderData, &orderDetail)
+	err = mysql.CreateOrderWithTransation(ctx, &orderData, orderDetails)
 	// err = errors.New("my error")
 	if err != nil {
 		zap.L().Error("mysql.CreateOrderWithTransation fail", zap.Error(err))
 		o.err = status.Error(codes.Internal, err.Error())
+		if errors.Is(err, mysql.ErrCouponNotUnused) {
+			o.err = status.Error(codes.InvalidArgument, err.Error())
+		}
 		return primitive.CommitMessageState
 	}
 
 	// 发送延时消息
 	// 向延时的topic发送消息，经过指定的时间才能会被下游的消费端进行消费
 	// 如果订单超过指定时间，就取消订单，需要向延时的topic投递回滚库存的消息
+	// 不带GoodsId，回滚整单的库存
 	data := model.OrderGoodsStockInfo{
 		OrderId: o.OrderId,
-		GoodsId: params.GoodsId,
-		Num:     params.Num,
 	}
 
 	b, _ := json.Marshal(data)
@@ -140,10 +177,19 @@ func (o *OrderMessageEntity) CheckLocalTransaction(*primitive.MessageExt) primit
 }
 
 func Create(ctx context.Context, params *proto.OrderReq) (*proto.OrderBaseResp, error) {
+	// 收货地址在扣减库存前校验，地址不存在时直接返回
+	addr, err := address.Get(ctx, params.GetUserId(), params.GetAddressId())
+	if errors.Is(err, address.ErrAddressNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
 	orderId := snowflake.GenID()
 	orderEntity := &OrderMessageEntity{
 		OrderId: orderId,
 		Param:   params,
+		Address: addr,
 	}
 
 	p, err := rocketmq.NewTransactionProducer(
@@ -161,11 +207,9 @@ func Create(ctx context.Context, params *proto.OrderReq) (*proto.OrderBaseResp,
 	p.Start()
 	defer p.Shutdown()
 
-	// 封装消息 orderId GoodsId num
+	// 封装消息 orderId，回滚时按订单归还所有商品行的库存
 	data := model.OrderGoodsStockInfo{
 		OrderId: orderId,
-		GoodsId: params.GoodsId,
-		Num:     params.Num,
 	}
 	b, _ := json.Marshal(data)
 	msg := &primitive.Message{
@@ -181,6 +225,9 @@ func Create(ctx context.Context, params *proto.OrderReq) (*proto.OrderBaseResp,
 	zap.L().Info("p.SendMessageInTransaction success", zap.Any("res", res))
 	// 如果回滚库存的消息被投递出去（commit）说明本地事务执行失败，也就是创建订单失败
 	if res.State == primitive.CommitMessageState {
+		if status.Code(orderEntity.err) == codes.InvalidArgument {
+			return nil, orderEntity.err
+		}
 		return nil, status.Error(codes.Internal, "create order failed")
 	}
 	// 其他内部错误
@@ -188,8 +235,9 @@ func Create(ctx context.Context, params *proto.OrderReq) (*proto.OrderBaseResp,
 		return nil, orderEntity.err
 	}
 	resp := &proto.OrderBaseResp{
-		Code: int32(codes.OK),
-		Msg:  "创建订单成功",
+		Code:    int32(codes.OK),
+		Msg:     "创建订单成功",
+		OrderId: orderId,
 	}
 	return resp, nil
 
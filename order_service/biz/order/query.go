@@ -0,0 +1,69 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+
+	"gorm.io/gorm"
+)
+
+// List 分页查询用户的订单，带上商品快照
+func List(ctx context.Context, req *proto.OrderListReq) (*proto.OrderListResp, error) {
+	pageNum, pageSize := int(req.GetPageNum()), int(req.GetPageSize())
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	orders, total, err := mysql.ListOrders(ctx, req.GetUserId(), (pageNum-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.OrderListResp{
+		Total: int32(total),
+		Data:  make([]*proto.OrderInfo, 0, len(orders)),
+	}
+	if len(orders) == 0 {
+		return resp, nil
+	}
+
+	idList := make([]int64, 0, len(orders))
+	for _, o := range orders {
+		idList = append(idList, o.OrderId)
+	}
+	details, err := mysql.GetOrderDetailsByOrderIds(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	lines := make(map[int64][]*model.OrderDetail, len(orders))
+	for _, d := range details {
+		lines[d.OrderId] = append(lines[d.OrderId], d)
+	}
+	for _, o := range orders {
+		resp.Data = append(resp.Data, toOrderInfo(o, lines[o.OrderId]))
+	}
+	return resp, nil
+}
+
+// Detail 查询用户自己的订单详情，不是自己的订单按不存在处理
+func Detail(ctx context.Context, orderId, userId int64) (*proto.OrderDetailInfo, error) {
+	o, err := mysql.QueryOrder(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if o.UserId != userId {
+		return nil, ErrOrderNotFound
+	}
+	details, err := mysql.GetOrderDetailList(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.OrderDetailInfo{OrderInfo: toOrderInfo(&o, details)}, nil
+}
@@ -0,0 +1,294 @@
+package order
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"money"
+	"order_service/biz/promotion"
+	"order_service/config"
+	"order_service/dao/redis"
+	"order_service/proto"
+	"order_service/rpc"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrInvalidQuote = errors.New("报价已失效，请重新确认订单")
+
+// 报价凭证的签名密钥至少32字节
+const minQuoteSecretLen = 32
+
+var quoteConf *config.QuoteConfig
+
+// InitQuote 检查报价凭证的配置，密钥为空或过短时拒绝启动
+func InitQuote(cfg *config.QuoteConfig) error {
+	if cfg == nil || len(cfg.Secret) < minQuoteSecretLen {
+		return errors.New("invalid QuoteConfig.Secret")
+	}
+	if cfg.Expire <= 0 {
+		return errors.New("invalid QuoteConfig.Expire")
+	}
+	quoteConf = cfg
+	return nil
+}
+
+// quote 订单报价：商品行、优惠和运费
+type quote struct {
+	Lines       []*orderLine
+	Promo       *promotion.Result
+	ShippingFee money.Money
+	PayAmount   money.Money
+}
+
+// quoteClaims 报价凭证中签名的内容，凭证只能使用一次
+type quoteClaims struct {
+	Id             string       `json:"jti"`
+	UserId         int64        `json:"uid"`
+	Lines          []*quoteLine `json:"lines"`
+	CouponId       int64        `json:"cid"`
+	OriginalAmount int64        `json:"oa"`
+	DiscountAmount int64        `json:"da"`
+	ShippingFee    int64        `json:"sf"`
+	PayAmount      int64        `json:"pa"`
+	ExpireAt       int64        `json:"exp"`
+}
+
+// quoteLine 报价时的商品行，记录成交单价和秒杀场次，按goodsId、skuId排序
+type quoteLine struct {
+	GoodsId   int64 `json:"g"`
+	SkuId     int64 `json:"s"`
+	RoomId    int64 `json:"r"`
+	Num       int64 `json:"n"`
+	SessionId int64 `json:"fs"`
+	Price     int64 `json:"p"`
+}
+
+// buildQuote 计算订单的商品金额、优惠和运费
+func buildQuote(ctx context.Context, params *proto.OrderReq, now time.Time) (*quote, error) {
+	lines, total, err := priceLines(ctx, params.GetUserId(), Lines(params), now)
+	if err != nil {
+		return nil, err
+	}
+	promo, err := promotion.Calculate(ctx, params.GetUserId(), params.GetCouponId(), total.Cent())
+	if err != nil {
+		return nil, err
+	}
+	q := &quote{
+		Lines:       lines,
+		Promo:       promo,
+		ShippingFee: shippingFee(money.FromCent(promo.PayAmount)),
+	}
+	q.PayAmount = money.FromCent(promo.PayAmount) + q.ShippingFee
+	return q, nil
+}
+
+// shippingFee 优惠后金额达到包邮门槛时免运费
+func shippingFee(amount money.Money) money.Money {
+	cfg := config.Conf.ShippingConfig
+	if cfg == nil {
+		return 0
+	}
+	if cfg.FreeThreshold > 0 && amount.Cent() >= cfg.FreeThreshold {
+		return 0
+	}
+	return money.FromCent(cfg.Fee)
+}
+
+// Preview 预览订单，返回逐行报价和下单时可使用的报价凭证
+func Preview(ctx context.Context, params *proto.OrderReq) (*proto.OrderQuote, error) {
+	now := time.Now()
+	q, err := buildQuote(ctx, params, now)
+	if err != nil {
+		return nil, err
+	}
+
+	storeReq := make([]*proto.GoodsStoreInfo, 0, len(q.Lines))
+	for _, line := range q.Lines {
+		storeReq = append(storeReq, &proto.GoodsStoreInfo{GoodsId: line.GoodsId, SkuId: line.SkuId, SessionId: line.SessionId})
+	}
+	stores, err := rpc.StoreCli.BatchGetStore(ctx, &proto.GoodsListStore{Data: storeReq})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.OrderQuote{
+		Items:          make([]*proto.QuoteItem, 0, len(q.Lines)),
+		OriginalAmount: q.Promo.OriginalAmount,
+		DiscountAmount: q.Promo.DiscountAmount,
+		ShippingFee:    q.ShippingFee.Cent(),
+		PayAmount:      q.PayAmount.Cent(),
+		PayAmountText:  q.PayAmount.String(),
+		Available:      true,
+	}
+	for i, line := range q.Lines {
+		item := &proto.QuoteItem{
+			GoodsId:   line.GoodsId,
+			SkuId:     line.SkuId,
+			SpecText:  line.Sku.GetSpecText(),
+			RoomId:    line.RoomId,
+			Num:       line.Num,
+			Title:     line.Detail.GetTitle(),
+			Price:     line.Price.String(),
+			PriceCent: line.Price.Cent(),
+			Amount:    line.Amount.Cent(),
+			SessionId: line.SessionId,
+		}
+		if i < len(stores.GetData()) {
+			item.Stock = stores.GetData()[i].GetNum()
+		}
+		item.Available = OnSale(line.Detail, line.Sku, now) && item.Stock >= line.Num
+		if !item.Available {
+			resp.Available = false
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	for _, d := range q.Promo.Items {
+		resp.Discounts = append(resp.Discounts, &proto.DiscountInfo{
+			Type:     d.Type,
+			Name:     d.Name,
+			Amount:   d.Amount,
+			CouponId: d.CouponId,
+		})
+	}
+
+	// 商品都可购买时才签发报价凭证
+	if resp.Available {
+		claims := q.claims(params)
+		claims.Id = newQuoteId()
+		claims.ExpireAt = now.Unix() + quoteConf.Expire
+		resp.QuoteToken = signQuote(claims)
+		resp.ExpireAt = claims.ExpireAt
+	}
+	return resp, nil
+}
+
+func (q *quote) claims(params *proto.OrderReq) *quoteClaims {
+	return &quoteClaims{
+		UserId:         params.GetUserId(),
+		Lines:          quoteLines(q.Lines),
+		CouponId:       params.GetCouponId(),
+		OriginalAmount: q.Promo.OriginalAmount,
+		DiscountAmount: q.Promo.DiscountAmount,
+		ShippingFee:    q.ShippingFee.Cent(),
+		PayAmount:      q.PayAmount.Cent(),
+	}
+}
+
+// honorQuote 校验下单请求携带的报价凭证，有效时按报价的单价和金额下单
+// 报价时参加的秒杀场次已结束，或者现在有报价时没有的场次，价格和扣减的库存都不一致，凭证失效
+// 凭证校验通过后标记为已使用，同一个凭证不能重复下单
+func (q *quote) honorQuote(ctx context.Context, params *proto.OrderReq, now time.Time) error {
+	claims, err := parseQuote(params.GetQuoteToken())
+	if err != nil {
+		return err
+	}
+	if claims.Id == "" ||
+		claims.ExpireAt < now.Unix() ||
+		claims.UserId != params.GetUserId() ||
+		claims.CouponId != params.GetCouponId() ||
+		linesKey(claims.Lines) != linesKey(quoteLines(q.Lines)) {
+		return ErrInvalidQuote
+	}
+	ok, err := redis.UseQuote(ctx, claims.Id, time.Unix(claims.ExpireAt, 0))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidQuote
+	}
+
+	// 商品行的快照按报价的单价保存，行金额之和与报价的商品金额一致
+	prices := make(map[[2]int64]int64, len(claims.Lines))
+	for _, l := range claims.Lines {
+		prices[[2]int64{l.GoodsId, l.SkuId}] = l.Price
+	}
+	for _, line := range q.Lines {
+		line.Price = money.FromCent(prices[[2]int64{line.GoodsId, line.SkuId}])
+		line.Amount = line.Price.Mul(line.Num)
+	}
+	q.Promo.OriginalAmount = claims.OriginalAmount
+	q.Promo.DiscountAmount = claims.DiscountAmount
+	q.Promo.PayAmount = claims.OriginalAmount - claims.DiscountAmount
+	q.ShippingFee = money.FromCent(claims.ShippingFee)
+	q.PayAmount = money.FromCent(claims.PayAmount)
+	// 优惠明细的金额与报价保持一致
+	if len(q.Promo.Items) == 1 {
+		q.Promo.Items[0].Amount = claims.DiscountAmount
+	}
+	return nil
+}
+
+// quoteLines 报价凭证中的商品行，与请求中商品行的顺序无关
+func quoteLines(lines []*orderLine) []*quoteLine {
+	res := make([]*quoteLine, 0, len(lines))
+	for _, line := range lines {
+		res = append(res, &quoteLine{
+			GoodsId:   line.GoodsId,
+			SkuId:     line.SkuId,
+			RoomId:    line.RoomId,
+			Num:       line.Num,
+			SessionId: line.SessionId,
+			Price:     line.Price.Cent(),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].GoodsId != res[j].GoodsId {
+			return res[i].GoodsId < res[j].GoodsId
+		}
+		return res[i].SkuId < res[j].SkuId
+	})
+	return res
+}
+
+// linesKey 比较报价和下单的商品行，不比较单价，单价以报价为准
+// 秒杀场次必须一致，否则按报价的秒杀价下单时扣减的不是场次的库存
+func linesKey(lines []*quoteLine) string {
+	keys := make([]string, 0, len(lines))
+	for _, l := range lines {
+		keys = append(keys, fmt.Sprintf("%d:%d:%d:%d:%d", l.GoodsId, l.SkuId, l.Num, l.RoomId, l.SessionId))
+	}
+	return strings.Join(keys, ",")
+}
+
+// newQuoteId 报价凭证的唯一id，用于限制凭证只能使用一次
+func newQuoteId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func signQuote(claims *quoteClaims) string {
+	b, _ := json.Marshal(claims)
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + quoteSignature(payload)
+}
+
+func parseQuote(token string) (*quoteClaims, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(quoteSignature(payload))) {
+		return nil, ErrInvalidQuote
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrInvalidQuote
+	}
+	var claims quoteClaims
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return nil, ErrInvalidQuote
+	}
+	return &claims, nil
+}
+
+func quoteSignature(payload string) string {
+	mac := hmac.New(sha256.New, []byte(quoteConf.Secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"money"
+	"order_service/config"
+	"order_service/proto"
+	"testing"
+	"time"
+)
+
+func TestHonorQuoteRejected(t *testing.T) {
+	quoteConf = &config.QuoteConfig{Secret: "0123456789abcdef0123456789abcdef", Expire: 600}
+	t.Cleanup(func() { quoteConf = nil })
+
+	now := time.Unix(1700000000, 0)
+	// 报价时商品2在秒杀场次7中
+	quoted := []*orderLine{
+		{GoodsId: 1, SkuId: 11, Num: 2, Price: money.FromCent(1000)},
+		{GoodsId: 2, Num: 1, SessionId: 7, Price: money.FromCent(99)},
+	}
+	sign := func(edit func(c *quoteClaims)) string {
+		c := &quoteClaims{
+			Id:       "q1",
+			UserId:   42,
+			Lines:    quoteLines(quoted),
+			ExpireAt: now.Unix() + 600,
+		}
+		if edit != nil {
+			edit(c)
+		}
+		return signQuote(c)
+	}
+	params := func(token string) *proto.OrderReq {
+		return &proto.OrderReq{UserId: 42, QuoteToken: token}
+	}
+	current := func(session int64, num int64) *quote {
+		return &quote{Lines: []*orderLine{
+			{GoodsId: 2, Num: num, SessionId: session, Price: money.FromCent(199)},
+			{GoodsId: 1, SkuId: 11, Num: 2, Price: money.FromCent(1200)},
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		q      *quote
+		userId int64
+	}{
+		{"session closed", sign(nil), current(0, 1), 42},
+		{"another session", sign(nil), current(8, 1), 42},
+		{"num changed", sign(nil), current(7, 2), 42},
+		{"another user", sign(nil), current(7, 1), 43},
+		{"expired", sign(func(c *quoteClaims) { c.ExpireAt = now.Unix() - 1 }), current(7, 1), 42},
+		{"no token id", sign(func(c *quoteClaims) { c.Id = "" }), current(7, 1), 42},
+		{"session not signed", sign(func(c *quoteClaims) { c.Lines[1].SessionId = 0 }), current(7, 1), 42},
+		{"tampered", sign(nil) + "x", current(7, 1), 42},
+	}
+	for _, tt := range tests {
+		p := params(tt.token)
+		p.UserId = tt.userId
+		if err := tt.q.honorQuote(context.Background(), p, now); !errors.Is(err, ErrInvalidQuote) {
+			t.Errorf("%s: honorQuote() err = %v, want %v", tt.name, err, ErrInvalidQuote)
+		}
+	}
+}
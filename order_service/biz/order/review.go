@@ -0,0 +1,47 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+	"order_service/rpc"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOrderNotFound    = errors.New("订单不存在")
+	ErrOrderNotFinished = errors.New("订单未完成，不能评价")
+	ErrLineNotFound     = errors.New("订单中没有该商品")
+)
+
+// Review 评价订单中的商品，只有用户自己已完成的订单行可以评价，评价由商品服务保存
+func Review(ctx context.Context, req *proto.CreateReviewReq) (*proto.ReviewInfo, error) {
+	o, err := mysql.QueryOrder(ctx, req.GetOrderId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 不是自己的订单按不存在处理
+	if o.UserId != req.GetUserId() {
+		return nil, ErrOrderNotFound
+	}
+	if o.Status != model.OrderStatusFinished {
+		return nil, ErrOrderNotFinished
+	}
+
+	lines, err := mysql.GetOrderDetailList(ctx, o.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		if line.GoodsId == req.GetGoodsId() && line.SkuId == req.GetSkuId() {
+			return rpc.GoodsCli.CreateReview(ctx, req)
+		}
+	}
+	return nil, ErrLineNotFound
+}
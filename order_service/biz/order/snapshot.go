@@ -0,0 +1,153 @@
+package order
+
+import (
+	"encoding/json"
+	"money"
+	"order_service/model"
+	"order_service/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 快照中列表字段序列化后的长度限制，与xx_order_detail表字段长度一致
+const (
+	maxSnapshotImgsLen   = 1024
+	maxSnapshotVideosLen = 1024
+	maxSnapshotDetailLen = 2048
+)
+
+// snapshotDetails 组装订单商品行，保存下单时的商品快照
+// payAmount为订单优惠后的商品金额（不含运费），按行金额分摊到每行
+func snapshotDetails(orderId, userId int64, lines []*orderLine, payAmount int64) []*model.OrderDetail {
+	payAmounts := allocatePayAmount(lines, payAmount)
+	data := make([]*model.OrderDetail, 0, len(lines))
+	for i, line := range lines {
+		marketPrice := line.Detail.GetMarketPriceCent()
+		if line.Sku != nil {
+			marketPrice = line.Sku.GetMarketPriceCent()
+		}
+		data = append(data, &model.OrderDetail{
+			OrderId:     orderId,
+			UserId:      userId,
+			GoodsId:     line.GoodsId,
+			SkuId:       line.SkuId,
+			RoomId:      line.RoomId,
+			Num:         line.Num,
+			Title:       line.Detail.GetTitle(),
+			SpecText:    line.Sku.GetSpecText(),
+			MarketPrice: marketPrice,
+			Price:       line.Price.Cent(),
+			Brief:       line.Detail.GetBrief(),
+			HeadImgs:    encodeList(snapshotImgs(line), maxSnapshotImgsLen),
+			Videos:      encodeList(line.Detail.GetVideos(), maxSnapshotVideosLen),
+			Detail:      encodeList(line.Detail.GetDetail(), maxSnapshotDetailLen),
+			PayAmount:   payAmounts[i],
+		})
+	}
+	return data
+}
+
+// snapshotImgs 有规格图片时排在最前，即买家下单时看到的图片
+func snapshotImgs(line *orderLine) []string {
+	imgs := line.Detail.GetHeadImgs()
+	if img := line.Sku.GetImage(); len(img) > 0 {
+		imgs = append([]string{img}, imgs...)
+	}
+	return imgs
+}
+
+// allocatePayAmount 按行金额比例分摊订单的支付金额，分摊的尾差由金额最大的行承担，各行之和等于payAmount
+// 按报价凭证下单时报价金额可能与当前行金额不同，差额同样按比例分摊
+func allocatePayAmount(lines []*orderLine, payAmount int64) []int64 {
+	var total int64
+	largest := 0
+	for i, line := range lines {
+		total += line.Amount.Cent()
+		if line.Amount > lines[largest].Amount {
+			largest = i
+		}
+	}
+	res := make([]int64, len(lines))
+	if total <= 0 {
+		if len(res) > 0 {
+			res[0] = payAmount
+		}
+		return res
+	}
+	remain := payAmount
+	for i, line := range lines {
+		res[i] = payAmount * line.Amount.Cent() / total
+		remain -= res[i]
+	}
+	res[largest] += remain
+	return res
+}
+
+// encodeList 序列化列表字段，超出长度时从末尾丢弃
+func encodeList(list []string, maxLen int) string {
+	for len(list) > 0 {
+		b, _ := json.Marshal(list)
+		if len(b) <= maxLen {
+			return string(b)
+		}
+		list = list[:len(list)-1]
+	}
+	return ""
+}
+
+// decodeList 反序列化列表字段，非法数据返回空列表
+func decodeList(s string) []string {
+	var list []string
+	json.Unmarshal([]byte(s), &list)
+	return list
+}
+
+func toOrderInfo(o *model.Order, details []*model.OrderDetail) *proto.OrderInfo {
+	info := &proto.OrderInfo{
+		OrderId:           o.OrderId,
+		UserId:            o.UserId,
+		Status:            o.Status,
+		PayAmount:         o.PayAmount,
+		OriginalAmount:    o.OriginalAmount,
+		DiscountAmount:    o.DiscountAmount,
+		ShippingFee:       o.ShippingFee,
+		CreateTime:        timestamppb.New(o.CreateAt),
+		ReceiveAddress:    o.ReceiveAddress,
+		ReceiveName:       o.ReceiveName,
+		ReceivePhone:      o.ReceivePhone,
+		AddressId:         o.AddressId,
+		ReceiveRegionCode: o.ReceiveRegionCode,
+		Lines:             make([]*proto.OrderLineInfo, 0, len(details)),
+	}
+	if o.PayTime != nil {
+		info.PayTime = timestamppb.New(*o.PayTime)
+	}
+	if o.DeliveredAt != nil {
+		info.DeliveredTime = timestamppb.New(*o.DeliveredAt)
+	}
+	for _, d := range details {
+		info.Lines = append(info.Lines, toOrderLineInfo(d))
+	}
+	return info
+}
+
+func toOrderLineInfo(d *model.OrderDetail) *proto.OrderLineInfo {
+	return &proto.OrderLineInfo{
+		GoodsId:         d.GoodsId,
+		SkuId:           d.SkuId,
+		RoomId:          d.RoomId,
+		Num:             d.Num,
+		Title:           d.Title,
+		SpecText:        d.SpecText,
+		Brief:           d.Brief,
+		HeadImgs:        decodeList(d.HeadImgs),
+		Videos:          decodeList(d.Videos),
+		Detail:          decodeList(d.Detail),
+		MarketPriceCent: d.MarketPrice,
+		PriceCent:       d.Price,
+		Price:           money.FromCent(d.Price).String(),
+		Amount:          money.FromCent(d.Price).Mul(d.Num).Cent(),
+		PayAmount:       d.PayAmount,
+		ShipmentId:      d.ShipmentId,
+	}
+}
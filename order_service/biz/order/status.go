@@ -0,0 +1,196 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"order_service/config"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/apache/rocketmq-client-go/v2/producer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// UpdateStatus 更新订单状态
+func UpdateStatus(ctx context.Context, orderId int64, st int32) (*proto.OrderBaseResp, error) {
+	var err error
+	switch st {
+	case model.OrderStatusPaid:
+		// 支付成功，核销优惠券，并通知商品服务累计销量
+		err = Pay(ctx, orderId)
+	case model.OrderStatusClosed:
+		// 取消订单，归还库存并释放优惠券
+		err = Cancel(ctx, orderId)
+	case model.OrderStatusFinished:
+		err = mysql.FinishOrder(ctx, orderId)
+	default:
+		err = mysql.ErrOrderStatus
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.OrderBaseResp{
+		Code: int32(codes.OK),
+		Msg:  "更新订单状态成功",
+	}
+	return resp, nil
+}
+
+// CloseMessageEntity 回滚库存消息的本地事务：关闭待支付订单并释放优惠券
+// 只有订单关闭成功后消息才会投递，避免订单同时被支付时库存已经归还
+type CloseMessageEntity struct {
+	OrderId int64
+	err     error
+}
+
+func (c *CloseMessageEntity) ExecuteLocalTransaction(*primitive.Message) primitive.LocalTransactionState {
+	c.err = mysql.CloseOrder(context.Background(), c.OrderId)
+	if c.err != nil {
+		return primitive.RollbackMessageState
+	}
+	return primitive.CommitMessageState
+}
+
+// CheckLocalTransaction 回查时订单已关闭说明本地事务已提交
+func (c *CloseMessageEntity) CheckLocalTransaction(msg *primitive.MessageExt) primitive.LocalTransactionState {
+	var info model.OrderGoodsStockInfo
+	if err := json.Unmarshal(msg.Body, &info); err != nil {
+		return primitive.RollbackMessageState
+	}
+	o, err := mysql.QueryOrder(context.Background(), info.OrderId)
+	if err != nil {
+		zap.L().Error("mysql.QueryOrder failed", zap.Int64("order_id", info.OrderId), zap.Error(err))
+		return primitive.UnknowState
+	}
+	if o.Status == model.OrderStatusClosed {
+		return primitive.CommitMessageState
+	}
+	return primitive.RollbackMessageState
+}
+
+// Cancel 取消待支付订单，关闭订单和发送回滚库存的消息在同一个事务消息中完成
+// 订单已支付或已关闭时返回ErrOrderStatus，不会重复回滚库存
+func Cancel(ctx context.Context, orderId int64) error {
+	o, err := mysql.QueryOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	if o.Status != model.OrderStatusUnpaid {
+		return mysql.ErrOrderStatus
+	}
+
+	// 按订单回滚所有商品行的库存
+	b, _ := json.Marshal(model.OrderGoodsStockInfo{OrderId: orderId})
+	entity := &CloseMessageEntity{OrderId: orderId}
+	msg := &primitive.Message{
+		Topic: config.Conf.RocketMqConfig.Topic.StoreRollback,
+		Body:  b,
+	}
+	if err := sendInTransaction(ctx, entity, "order_srv_close", msg); err != nil {
+		return err
+	}
+	return entity.err
+}
+
+// PaidMessageEntity 支付成功消息的本地事务：订单更新为已支付并核销优惠券
+// 本地事务提交后消息才会投递，商品服务按订单幂等累计销量
+type PaidMessageEntity struct {
+	OrderId int64
+	err     error
+}
+
+func (p *PaidMessageEntity) ExecuteLocalTransaction(*primitive.Message) primitive.LocalTransactionState {
+	p.err = mysql.PayOrder(context.Background(), p.OrderId)
+	if p.err != nil {
+		return primitive.RollbackMessageState
+	}
+	return primitive.CommitMessageState
+}
+
+// CheckLocalTransaction 回查时按订单状态判断本地事务是否提交
+// 回查可能由同组的其他生产者处理，订单id从消息中取
+func (p *PaidMessageEntity) CheckLocalTransaction(msg *primitive.MessageExt) primitive.LocalTransactionState {
+	var info model.OrderPaidInfo
+	if err := json.Unmarshal(msg.Body, &info); err != nil {
+		return primitive.RollbackMessageState
+	}
+	o, err := mysql.QueryOrder(context.Background(), info.OrderId)
+	if err != nil {
+		zap.L().Error("mysql.QueryOrder failed", zap.Int64("order_id", info.OrderId), zap.Error(err))
+		return primitive.UnknowState
+	}
+	switch o.Status {
+	case model.OrderStatusPaid, model.OrderStatusShipped, model.OrderStatusFinished:
+		return primitive.CommitMessageState
+	}
+	return primitive.RollbackMessageState
+}
+
+// Pay 订单支付成功，更新订单状态和发送支付成功的消息在同一个事务消息中完成
+func Pay(ctx context.Context, orderId int64) error {
+	o, err := mysql.QueryOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	if o.Status != model.OrderStatusUnpaid {
+		return mysql.ErrOrderStatus
+	}
+	details, err := mysql.GetOrderDetailList(ctx, orderId)
+	if err != nil {
+		return err
+	}
+	info := model.OrderPaidInfo{
+		OrderId: o.OrderId,
+		UserId:  o.UserId,
+		Lines:   make([]*model.OrderPaidLine, 0, len(details)),
+	}
+	for _, d := range details {
+		info.Lines = append(info.Lines, &model.OrderPaidLine{
+			GoodsId: d.GoodsId,
+			SkuId:   d.SkuId,
+			RoomId:  d.RoomId,
+			Num:     d.Num,
+		})
+	}
+	b, _ := json.Marshal(info)
+
+	entity := &PaidMessageEntity{OrderId: orderId}
+	msg := &primitive.Message{
+		Topic: config.Conf.RocketMqConfig.Topic.OrderPaid,
+		Body:  b,
+	}
+	if err := sendInTransaction(ctx, entity, "order_srv_paid", msg); err != nil {
+		return err
+	}
+	// 本地事务失败时消息被丢弃，返回本地事务的错误
+	return entity.err
+}
+
+// sendInTransaction 发送事务消息，本地事务的结果由listener记录
+// 半消息发送失败时本地事务还没有执行，调用方重试即可
+func sendInTransaction(ctx context.Context, listener primitive.TransactionListener, group string, msg *primitive.Message) error {
+	p, err := rocketmq.NewTransactionProducer(
+		listener,
+		producer.WithNsResolver(primitive.NewPassthroughResolver([]string{config.Conf.RocketMqConfig.Addr})),
+		producer.WithRetry(2),
+		producer.WithGroupName(group),
+	)
+	if err != nil {
+		zap.L().Error("rocketmq.NewTransactionProducer failed", zap.Error(err))
+		return err
+	}
+	if err := p.Start(); err != nil {
+		return err
+	}
+	defer p.Shutdown()
+
+	if _, err := p.SendMessageInTransaction(ctx, msg); err != nil {
+		zap.L().Error("p.SendMessageInTransaction failed", zap.String("topic", msg.Topic), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+	"order_service/third_party/snowflake"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidTemplate  = errors.New("优惠券模板参数有误")
+	ErrTemplateNotFound = errors.New("优惠券模板不存在")
+	ErrTemplateExpired  = errors.New("优惠券已过期")
+)
+
+// CreateTemplate 创建优惠券模板
+func CreateTemplate(ctx context.Context, req *proto.CouponTemplateInfo) (*proto.CouponTemplateInfo, error) {
+	if err := checkTemplate(req); err != nil {
+		return nil, err
+	}
+	data := &model.CouponTemplate{
+		TemplateId:   snowflake.GenID(),
+		Name:         req.GetName(),
+		Type:         req.GetType(),
+		Amount:       req.GetAmount(),
+		Rate:         req.GetRate(),
+		Threshold:    req.GetThreshold(),
+		MaxDiscount:  req.GetMaxDiscount(),
+		Total:        req.GetTotal(),
+		PerUserLimit: req.GetPerUserLimit(),
+		StartTime:    time.Unix(req.GetStartTime(), 0),
+		EndTime:      time.Unix(req.GetEndTime(), 0),
+	}
+	if err := mysql.CreateCouponTemplate(ctx, data); err != nil {
+		return nil, err
+	}
+	return toTemplateInfo(data), nil
+}
+
+func checkTemplate(req *proto.CouponTemplateInfo) error {
+	if len(req.GetName()) == 0 || req.GetTotal() <= 0 || req.GetEndTime() <= req.GetStartTime() {
+		return ErrInvalidTemplate
+	}
+	switch req.GetType() {
+	case model.CouponTypeFixed:
+		if req.GetAmount() <= 0 {
+			return ErrInvalidTemplate
+		}
+	case model.CouponTypeThreshold:
+		// 满减券的优惠金额不能超过门槛
+		if req.GetAmount() <= 0 || req.GetThreshold() < req.GetAmount() {
+			return ErrInvalidTemplate
+		}
+	case model.CouponTypePercent:
+		if req.GetRate() <= 0 || req.GetRate() >= 100 {
+			return ErrInvalidTemplate
+		}
+	default:
+		return ErrInvalidTemplate
+	}
+	return nil
+}
+
+// Issue 给用户发放一张优惠券
+func Issue(ctx context.Context, templateId, userId int64) (*proto.UserCouponInfo, error) {
+	tpl, err := mysql.GetCouponTemplate(ctx, templateId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(tpl.EndTime) {
+		return nil, ErrTemplateExpired
+	}
+
+	data := &model.UserCoupon{
+		CouponId:   snowflake.GenID(),
+		TemplateId: templateId,
+		UserId:     userId,
+		Status:     model.CouponStatusUnused,
+	}
+	if err := mysql.IssueCoupon(ctx, tpl, data); err != nil {
+		return nil, err
+	}
+	return toUserCouponInfo(data, tpl), nil
+}
+
+// UserCouponList 用户优惠券列表
+func UserCouponList(ctx context.Context, userId int64, status int32) (*proto.UserCouponListResp, error) {
+	list, err := mysql.GetUserCouponList(ctx, userId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	idList := make([]int64, 0, len(list))
+	for _, uc := range list {
+		idList = append(idList, uc.TemplateId)
+	}
+	tplMap := make(map[int64]*model.CouponTemplate, len(idList))
+	if len(idList) > 0 {
+		tplList, err := mysql.GetCouponTemplateList(ctx, idList)
+		if err != nil {
+			return nil, err
+		}
+		for _, tpl := range tplList {
+			tplMap[tpl.TemplateId] = tpl
+		}
+	}
+
+	data := make([]*proto.UserCouponInfo, 0, len(list))
+	for _, uc := range list {
+		data = append(data, toUserCouponInfo(uc, tplMap[uc.TemplateId]))
+	}
+	return &proto.UserCouponListResp{Data: data}, nil
+}
+
+func toTemplateInfo(tpl *model.CouponTemplate) *proto.CouponTemplateInfo {
+	if tpl == nil {
+		return nil
+	}
+	return &proto.CouponTemplateInfo{
+		TemplateId:   tpl.TemplateId,
+		Name:         tpl.Name,
+		Type:         tpl.Type,
+		Amount:       tpl.Amount,
+		Rate:         tpl.Rate,
+		Threshold:    tpl.Threshold,
+		MaxDiscount:  tpl.MaxDiscount,
+		Total:        tpl.Total,
+		PerUserLimit: tpl.PerUserLimit,
+		StartTime:    tpl.StartTime.Unix(),
+		EndTime:      tpl.EndTime.Unix(),
+	}
+}
+
+func toUserCouponInfo(uc *model.UserCoupon, tpl *model.CouponTemplate) *proto.UserCouponInfo {
+	return &proto.UserCouponInfo{
+		CouponId: uc.CouponId,
+		UserId:   uc.UserId,
+		Status:   uc.Status,
+		OrderId:  uc.OrderId,
+		Template: toTemplateInfo(tpl),
+	}
+}
@@ -0,0 +1,96 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"money"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrCouponUnavailable = errors.New("优惠券不可用")
+
+// DiscountItem 订单优惠明细
+type DiscountItem struct {
+	Type     string `json:"type"`
+	CouponId int64  `json:"couponId,omitempty"`
+	Name     string `json:"name"`
+	Amount   int64  `json:"amount"`
+}
+
+// Result 订单优惠计算结果，金额单位为分
+type Result struct {
+	OriginalAmount int64
+	DiscountAmount int64
+	PayAmount      int64
+	CouponId       int64
+	Items          []DiscountItem
+}
+
+// Calculate 计算订单金额可享受的优惠
+func Calculate(ctx context.Context, userId, couponId, amount int64) (*Result, error) {
+	res := &Result{
+		OriginalAmount: amount,
+		PayAmount:      amount,
+	}
+	if couponId <= 0 {
+		return res, nil
+	}
+
+	uc, err := mysql.GetUserCoupon(ctx, couponId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCouponUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if uc.UserId != userId || uc.Status != model.CouponStatusUnused {
+		return nil, ErrCouponUnavailable
+	}
+
+	tpl, err := mysql.GetCouponTemplate(ctx, uc.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if now.Before(tpl.StartTime) || !now.Before(tpl.EndTime) || amount < tpl.Threshold {
+		return nil, ErrCouponUnavailable
+	}
+
+	discount := CouponDiscount(tpl, amount)
+	res.DiscountAmount = discount
+	res.PayAmount = amount - discount
+	res.CouponId = couponId
+	res.Items = append(res.Items, DiscountItem{
+		Type:     "coupon",
+		CouponId: couponId,
+		Name:     tpl.Name,
+		Amount:   discount,
+	})
+	return res, nil
+}
+
+// CouponDiscount 计算优惠券对订单金额的优惠，优惠不超过订单金额
+func CouponDiscount(tpl *model.CouponTemplate, amount int64) int64 {
+	var discount int64
+	switch tpl.Type {
+	case model.CouponTypeFixed, model.CouponTypeThreshold:
+		discount = tpl.Amount
+	case model.CouponTypePercent:
+		// 折扣优惠向下取整到分
+		discount = money.FromCent(amount).Percent(int64(100 - tpl.Rate)).Cent()
+		if tpl.MaxDiscount > 0 && discount > tpl.MaxDiscount {
+			discount = tpl.MaxDiscount
+		}
+	}
+	if discount > amount {
+		discount = amount
+	}
+	if discount < 0 {
+		discount = 0
+	}
+	return discount
+}
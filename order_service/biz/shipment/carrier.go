@@ -0,0 +1,66 @@
+package shipment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"order_service/config"
+	"sync"
+	"time"
+)
+
+var ErrInvalidWebhook = errors.New("物流推送请求无效")
+
+const minFakeCarrierSecretLen = 32
+
+// TrackingEvent 承运商推送的一条物流轨迹，Status使用包裹的物流状态
+type TrackingEvent struct {
+	TrackingNo  string
+	Status      int32
+	Description string
+	Location    string
+	EventTime   time.Time
+}
+
+// Carrier 承运商适配器，对接新的承运商只需要实现该接口并在Init中注册
+type Carrier interface {
+	// Code 承运商编码，同时是推送地址的最后一段 /v1/shipment/webhook/<code>
+	Code() string
+	// Subscribe 向承运商订阅运单的轨迹推送，发货后调用
+	Subscribe(ctx context.Context, trackingNo string) error
+	// ParseWebhook 校验承运商推送请求的签名并解析出轨迹，请求无效时返回ErrInvalidWebhook
+	ParseWebhook(r *http.Request) ([]*TrackingEvent, error)
+}
+
+var (
+	mu       sync.RWMutex
+	carriers = make(map[string]Carrier)
+)
+
+// Init 注册已对接的承运商
+// 测试承运商只在配置开启时注册，开启后密钥为空或过短时拒绝启动
+func Init(cfg *config.FulfilmentConfig) error {
+	if cfg == nil || !cfg.FakeCarrier {
+		return nil
+	}
+	if len(cfg.FakeCarrierSecret) < minFakeCarrierSecretLen {
+		return errors.New("invalid FulfilmentConfig.FakeCarrierSecret")
+	}
+	Register(NewFakeCarrier(cfg.FakeCarrierSecret))
+	return nil
+}
+
+// Register 注册承运商，编码相同时后注册的覆盖先注册的
+func Register(c Carrier) {
+	mu.Lock()
+	defer mu.Unlock()
+	carriers[c.Code()] = c
+}
+
+// GetCarrier 按编码查询承运商
+func GetCarrier(code string) (Carrier, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	c, ok := carriers[code]
+	return c, ok
+}
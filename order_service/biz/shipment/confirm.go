@@ -0,0 +1,55 @@
+package shipment
+
+import (
+	"context"
+	"errors"
+	"order_service/config"
+	"order_service/dao/mysql"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// 每次扫描确认收货的订单数
+const confirmBatchSize = 200
+
+// StartAutoConfirm 定时扫描签收后超过确认期限的订单，自动确认收货
+func StartAutoConfirm(ctx context.Context, cfg *config.FulfilmentConfig) {
+	interval := time.Minute
+	window := 7 * 24 * time.Hour
+	if cfg != nil && cfg.Interval > 0 {
+		interval = time.Duration(cfg.Interval) * time.Second
+	}
+	if cfg != nil && cfg.ConfirmDays > 0 {
+		window = time.Duration(cfg.ConfirmDays) * 24 * time.Hour
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if err := autoConfirm(ctx, now.Add(-window)); err != nil {
+					zap.L().Error("shipment.autoConfirm failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// autoConfirm 处理一批签收时间早于before的订单，用户已手动确认的跳过
+func autoConfirm(ctx context.Context, before time.Time) error {
+	idList, err := mysql.GetDeliveredOrderIds(ctx, before, confirmBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, orderId := range idList {
+		err := mysql.FinishOrder(ctx, orderId)
+		if err != nil && !errors.Is(err, mysql.ErrOrderStatus) {
+			zap.L().Error("mysql.FinishOrder failed", zap.Int64("order_id", orderId), zap.Error(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package shipment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"order_service/model"
+	"time"
+)
+
+const (
+	FakeCarrierCode     = "fake"
+	fakeSignatureHeader = "X-Fake-Signature"
+	maxWebhookBody      = 1 << 20
+)
+
+// FakeCarrier 测试用的承运商，不对接真实的物流系统，轨迹由测试方按约定格式推送
+// 推送的请求体用密钥做HMAC-SHA256签名，十六进制放在X-Fake-Signature请求头
+type FakeCarrier struct {
+	secret []byte
+}
+
+func NewFakeCarrier(secret string) *FakeCarrier {
+	return &FakeCarrier{secret: []byte(secret)}
+}
+
+type fakeWebhookBody struct {
+	Events []struct {
+		TrackingNo  string `json:"trackingNo"`
+		Status      int32  `json:"status"`
+		Description string `json:"description"`
+		Location    string `json:"location"`
+		Time        int64  `json:"time"` // unix秒
+	} `json:"events"`
+}
+
+func (c *FakeCarrier) Code() string {
+	return FakeCarrierCode
+}
+
+// Subscribe 测试承运商不需要订阅，轨迹直接推送
+func (c *FakeCarrier) Subscribe(ctx context.Context, trackingNo string) error {
+	return nil
+}
+
+func (c *FakeCarrier) ParseWebhook(r *http.Request) ([]*TrackingEvent, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(r.Header.Get(fakeSignatureHeader))
+	if err != nil || !hmac.Equal(sig, c.sign(body)) {
+		return nil, ErrInvalidWebhook
+	}
+	var data fakeWebhookBody
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, ErrInvalidWebhook
+	}
+	events := make([]*TrackingEvent, 0, len(data.Events))
+	for _, e := range data.Events {
+		if e.TrackingNo == "" || e.Time <= 0 || e.Status < model.ShipmentInTransit || e.Status > model.ShipmentException {
+			return nil, ErrInvalidWebhook
+		}
+		events = append(events, &TrackingEvent{
+			TrackingNo:  e.TrackingNo,
+			Status:      e.Status,
+			Description: e.Description,
+			Location:    e.Location,
+			EventTime:   time.Unix(e.Time, 0),
+		})
+	}
+	return events, nil
+}
+
+// Sign 生成推送请求的签名，供测试方构造请求
+func (c *FakeCarrier) Sign(body []byte) string {
+	return hex.EncodeToString(c.sign(body))
+}
+
+func (c *FakeCarrier) sign(body []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,100 @@
+package shipment
+
+import (
+	"errors"
+	"net/http/httptest"
+	"order_service/config"
+	"order_service/model"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestFakeCarrierParseWebhook(t *testing.T) {
+	c := NewFakeCarrier(testSecret)
+	valid := `{"events":[{"trackingNo":"SF1","status":1,"description":"已揽收","location":"深圳","time":1700000000},` +
+		`{"trackingNo":"SF1","status":2,"description":"已签收","location":"上海","time":1700086400}]}`
+	// sig为空时用正确的密钥签名，"-"表示不带签名
+	tests := []struct {
+		name    string
+		body    string
+		sig     string
+		want    int
+		wantErr error
+	}{
+		{"valid", valid, "", 2, nil},
+		{"empty events", `{"events":[]}`, "", 0, nil},
+		{"missing signature", valid, "-", 0, ErrInvalidWebhook},
+		{"signature not hex", valid, "zz", 0, ErrInvalidWebhook},
+		{"wrong secret", valid, NewFakeCarrier("another secret").Sign([]byte(valid)), 0, ErrInvalidWebhook},
+		{"body tampered", valid + " ", c.Sign([]byte(valid)), 0, ErrInvalidWebhook},
+		{"not json", `events`, "", 0, ErrInvalidWebhook},
+		{"empty tracking no", `{"events":[{"status":1,"time":1700000000}]}`, "", 0, ErrInvalidWebhook},
+		{"no time", `{"events":[{"trackingNo":"SF1","status":1}]}`, "", 0, ErrInvalidWebhook},
+		{"unknown status", `{"events":[{"trackingNo":"SF1","status":4,"time":1700000000}]}`, "", 0, ErrInvalidWebhook},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("POST", "/v1/shipment/webhook/fake", strings.NewReader(tt.body))
+		switch tt.sig {
+		case "":
+			r.Header.Set(fakeSignatureHeader, c.Sign([]byte(tt.body)))
+		case "-":
+		default:
+			r.Header.Set(fakeSignatureHeader, tt.sig)
+		}
+		events, err := c.ParseWebhook(r)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(events) != tt.want {
+			t.Errorf("%s: got %d events, want %d", tt.name, len(events), tt.want)
+		}
+	}
+
+	r := httptest.NewRequest("POST", "/v1/shipment/webhook/fake", strings.NewReader(valid))
+	r.Header.Set(fakeSignatureHeader, c.Sign([]byte(valid)))
+	events, err := c.ParseWebhook(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &TrackingEvent{
+		TrackingNo:  "SF1",
+		Status:      model.ShipmentDelivered,
+		Description: "已签收",
+		Location:    "上海",
+		EventTime:   time.Unix(1700086400, 0),
+	}
+	if got := events[1]; *got != *want {
+		t.Errorf("events[1] = %+v, want %+v", got, want)
+	}
+}
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *config.FulfilmentConfig
+		wantErr  bool
+		wantFake bool
+	}{
+		{"nil config", nil, false, false},
+		{"disabled", &config.FulfilmentConfig{FakeCarrierSecret: testSecret}, false, false},
+		{"empty secret", &config.FulfilmentConfig{FakeCarrier: true}, true, false},
+		{"short secret", &config.FulfilmentConfig{FakeCarrier: true, FakeCarrierSecret: "xx_fake_carrier_secret"}, true, false},
+		{"enabled", &config.FulfilmentConfig{FakeCarrier: true, FakeCarrierSecret: testSecret}, false, true},
+	}
+	for _, tt := range tests {
+		mu.Lock()
+		carriers = make(map[string]Carrier)
+		mu.Unlock()
+		err := Init(tt.cfg)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if _, ok := GetCarrier(FakeCarrierCode); ok != tt.wantFake {
+			t.Errorf("%s: fake carrier registered = %v, want %v", tt.name, ok, tt.wantFake)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package shipment
+
+import (
+	"context"
+	"errors"
+	"order_service/biz/order"
+	"order_service/dao/mysql"
+	"order_service/model"
+	"order_service/proto"
+	"order_service/third_party/snowflake"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var ErrCarrierNotFound = errors.New("不支持该承运商")
+
+// 推送入库用到的数据访问，测试时替换
+var (
+	getShipmentByTrackingNo = mysql.GetShipmentByTrackingNo
+	addShipmentEvents       = mysql.AddShipmentEvents
+)
+
+// Create 订单发货，未指定商品行时发出所有未发货的商品行
+// 包裹创建成功后向承运商订阅轨迹推送，订阅失败只记录日志
+func Create(ctx context.Context, req *proto.CreateShipmentReq, operator string) (*proto.ShipmentInfo, error) {
+	carrier, ok := GetCarrier(req.GetCarrier())
+	if !ok {
+		return nil, ErrCarrierNotFound
+	}
+	details, err := mysql.GetOrderDetailList(ctx, req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	if len(details) == 0 {
+		return nil, order.ErrOrderNotFound
+	}
+	lines, err := shipLines(details, req.GetLines())
+	if err != nil {
+		return nil, err
+	}
+
+	data := &model.Shipment{
+		ShipmentId: snowflake.GenID(),
+		OrderId:    req.GetOrderId(),
+		Carrier:    carrier.Code(),
+		TrackingNo: req.GetTrackingNo(),
+		Status:     model.ShipmentInTransit,
+		ShippedAt:  time.Now(),
+	}
+	data.CreateBy = operator
+	data.UpdateBy = operator
+	detailIds := make([]uint, 0, len(lines))
+	for _, d := range lines {
+		detailIds = append(detailIds, d.ID)
+	}
+	err = mysql.CreateShipment(ctx, data, detailIds)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, order.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := carrier.Subscribe(ctx, data.TrackingNo); err != nil {
+		zap.L().Warn("carrier.Subscribe failed", zap.String("carrier", data.Carrier), zap.String("tracking_no", data.TrackingNo), zap.Error(err))
+	}
+	return toShipmentInfo(data, lines, nil), nil
+}
+
+// shipLines 本次发货的商品行，同一商品行只能在一个包裹中
+func shipLines(details []*model.OrderDetail, req []*proto.ShipmentLine) ([]*model.OrderDetail, error) {
+	lines := make([]*model.OrderDetail, 0, len(details))
+	if len(req) == 0 {
+		for _, d := range details {
+			if d.ShipmentId == 0 {
+				lines = append(lines, d)
+			}
+		}
+		if len(lines) == 0 {
+			return nil, mysql.ErrLineShipped
+		}
+		return lines, nil
+	}
+
+	seen := make(map[uint]struct{}, len(req))
+	for _, l := range req {
+		var line *model.OrderDetail
+		for _, d := range details {
+			if d.GoodsId == l.GetGoodsId() && d.SkuId == l.GetSkuId() {
+				line = d
+				break
+			}
+		}
+		if line == nil {
+			return nil, order.ErrLineNotFound
+		}
+		if line.ShipmentId != 0 {
+			return nil, mysql.ErrLineShipped
+		}
+		if _, ok := seen[line.ID]; ok {
+			continue
+		}
+		seen[line.ID] = struct{}{}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// List 用户订单的包裹和物流轨迹，不是自己的订单按不存在处理
+func List(ctx context.Context, orderId, userId int64) (*proto.ListShipmentsResp, error) {
+	o, err := mysql.QueryOrder(ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && o.UserId != userId) {
+		return nil, order.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	shipments, err := mysql.GetShipmentList(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListShipmentsResp{Data: make([]*proto.ShipmentInfo, 0, len(shipments))}
+	if len(shipments) == 0 {
+		return resp, nil
+	}
+
+	details, err := mysql.GetOrderDetailList(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	lines := make(map[int64][]*model.OrderDetail, len(shipments))
+	for _, d := range details {
+		lines[d.ShipmentId] = append(lines[d.ShipmentId], d)
+	}
+	idList := make([]int64, 0, len(shipments))
+	for _, s := range shipments {
+		idList = append(idList, s.ShipmentId)
+	}
+	list, err := mysql.GetShipmentEvents(ctx, idList)
+	if err != nil {
+		return nil, err
+	}
+	events := make(map[int64][]*model.ShipmentEvent, len(shipments))
+	for _, e := range list {
+		events[e.ShipmentId] = append(events[e.ShipmentId], e)
+	}
+	for _, s := range shipments {
+		resp.Data = append(resp.Data, toShipmentInfo(s, lines[s.ShipmentId], events[s.ShipmentId]))
+	}
+	return resp, nil
+}
+
+// Ingest 记录承运商推送的物流轨迹，不是本系统的运单忽略
+// 返回错误时承运商会重试推送，重复的轨迹按唯一索引去重
+func Ingest(ctx context.Context, carrier string, events []*TrackingEvent) error {
+	byTrackingNo := make(map[string][]*model.ShipmentEvent)
+	var trackingNos []string
+	for _, e := range events {
+		if _, ok := byTrackingNo[e.TrackingNo]; !ok {
+			trackingNos = append(trackingNos, e.TrackingNo)
+		}
+		byTrackingNo[e.TrackingNo] = append(byTrackingNo[e.TrackingNo], &model.ShipmentEvent{
+			Status:      e.Status,
+			Description: truncate(e.Description, 255),
+			Location:    truncate(e.Location, 128),
+			EventTime:   e.EventTime,
+		})
+	}
+	for _, trackingNo := range trackingNos {
+		data, err := getShipmentByTrackingNo(ctx, carrier, trackingNo)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			zap.L().Warn("unknown tracking no", zap.String("carrier", carrier), zap.String("tracking_no", trackingNo))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		list := byTrackingNo[trackingNo]
+		for _, e := range list {
+			e.ShipmentId = data.ShipmentId
+			e.CreateBy = carrier
+			e.UpdateBy = carrier
+		}
+		if err := addShipmentEvents(ctx, data.ShipmentId, list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// truncate 按字符截断，避免超过字段长度
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+func toShipmentInfo(data *model.Shipment, lines []*model.OrderDetail, events []*model.ShipmentEvent) *proto.ShipmentInfo {
+	info := &proto.ShipmentInfo{
+		ShipmentId:  data.ShipmentId,
+		OrderId:     data.OrderId,
+		Carrier:     data.Carrier,
+		TrackingNo:  data.TrackingNo,
+		Status:      data.Status,
+		ShippedTime: timestamppb.New(data.ShippedAt),
+		Lines:       make([]*proto.ShipmentLine, 0, len(lines)),
+		Events:      make([]*proto.ShipmentEventInfo, 0, len(events)),
+	}
+	if data.DeliveredAt != nil {
+		info.DeliveredTime = timestamppb.New(*data.DeliveredAt)
+	}
+	for _, d := range lines {
+		info.Lines = append(info.Lines, &proto.ShipmentLine{GoodsId: d.GoodsId, SkuId: d.SkuId})
+	}
+	for _, e := range events {
+		info.Events = append(info.Events, &proto.ShipmentEventInfo{
+			Status:      e.Status,
+			Description: e.Description,
+			Location:    e.Location,
+			EventTime:   timestamppb.New(e.EventTime),
+		})
+	}
+	return info
+}
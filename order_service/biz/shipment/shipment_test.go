@@ -0,0 +1,93 @@
+package shipment
+
+import (
+	"context"
+	"errors"
+	"order_service/model"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// fakeStore 按运单号保存包裹，记录写入的轨迹
+type fakeStore struct {
+	shipments map[string]*model.Shipment
+	added     map[int64][]*model.ShipmentEvent
+	addErr    error
+}
+
+func useFakeStore(t *testing.T, s *fakeStore) {
+	getOld, addOld := getShipmentByTrackingNo, addShipmentEvents
+	t.Cleanup(func() {
+		getShipmentByTrackingNo, addShipmentEvents = getOld, addOld
+	})
+	getShipmentByTrackingNo = func(ctx context.Context, carrier, trackingNo string) (*model.Shipment, error) {
+		data, ok := s.shipments[carrier+"/"+trackingNo]
+		if !ok {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return data, nil
+	}
+	addShipmentEvents = func(ctx context.Context, shipmentId int64, events []*model.ShipmentEvent) error {
+		if s.addErr != nil {
+			return s.addErr
+		}
+		s.added[shipmentId] = append(s.added[shipmentId], events...)
+		return nil
+	}
+}
+
+func TestIngest(t *testing.T) {
+	s := &fakeStore{
+		shipments: map[string]*model.Shipment{
+			"fake/SF1": {ShipmentId: 101},
+			"fake/SF2": {ShipmentId: 102},
+		},
+		added: make(map[int64][]*model.ShipmentEvent),
+	}
+	useFakeStore(t, s)
+
+	now := time.Unix(1700000000, 0)
+	events := []*TrackingEvent{
+		{TrackingNo: "SF1", Status: model.ShipmentInTransit, Description: "已揽收", EventTime: now},
+		{TrackingNo: "SF2", Status: model.ShipmentInTransit, Location: strings.Repeat("长", 200), EventTime: now},
+		{TrackingNo: "SF404", Status: model.ShipmentInTransit, EventTime: now},
+		{TrackingNo: "SF1", Status: model.ShipmentDelivered, Description: "已签收", EventTime: now.Add(time.Hour)},
+	}
+	if err := Ingest(context.Background(), FakeCarrierCode, events); err != nil {
+		t.Fatalf("Ingest() err = %v", err)
+	}
+
+	// 同一运单的轨迹一起写入，未知运单跳过
+	if len(s.added) != 2 || len(s.added[101]) != 2 || len(s.added[102]) != 1 {
+		t.Fatalf("added = %v, want 2 events for 101 and 1 for 102", s.added)
+	}
+	for _, e := range s.added[101] {
+		if e.ShipmentId != 101 || e.CreateBy != FakeCarrierCode || e.UpdateBy != FakeCarrierCode {
+			t.Errorf("event = %+v, want shipment 101 created by %s", e, FakeCarrierCode)
+		}
+	}
+	if got := s.added[101][1]; got.Status != model.ShipmentDelivered || !got.EventTime.Equal(now.Add(time.Hour)) {
+		t.Errorf("second event = %+v, want delivered at %v", got, now.Add(time.Hour))
+	}
+	if got := []rune(s.added[102][0].Location); len(got) != 128 {
+		t.Errorf("location length = %d, want truncated to 128", len(got))
+	}
+}
+
+func TestIngestError(t *testing.T) {
+	want := errors.New("db down")
+	s := &fakeStore{
+		shipments: map[string]*model.Shipment{"fake/SF1": {ShipmentId: 101}},
+		added:     make(map[int64][]*model.ShipmentEvent),
+		addErr:    want,
+	}
+	useFakeStore(t, s)
+
+	events := []*TrackingEvent{{TrackingNo: "SF1", Status: model.ShipmentInTransit, EventTime: time.Now()}}
+	if err := Ingest(context.Background(), FakeCarrierCode, events); !errors.Is(err, want) {
+		t.Errorf("Ingest() err = %v, want %v", err, want)
+	}
+}
@@ -27,6 +27,19 @@ type Config struct {
 	*StoreService `mapstructure:"store_service"`
 
 	*RocketMqConfig `mapstructure:"rocketmq"`
+
+	*ShippingConfig `mapstructure:"shipping"`
+	*QuoteConfig    `mapstructure:"quote"`
+
+	*FulfilmentConfig `mapstructure:"fulfilment"`
+
+	*AuthConfig `mapstructure:"auth"`
+}
+
+// AuthConfig 登录令牌，Secret与用户服务一致；ServiceSecret用于服务间调用，用户服务没有这个密钥
+type AuthConfig struct {
+	Secret        string `mapstructure:"secret"`         // 通过环境变量AUTH_SECRET设置
+	ServiceSecret string `mapstructure:"service_secret"` // 通过环境变量SERVICE_AUTH_SECRET设置
 }
 
 type LogConfig struct {
@@ -73,11 +86,43 @@ type RocketMqConfig struct {
 	Topic   struct {
 		PayTimeout    string `mapstructure:"pay_timeout"`
 		StoreRollback string `mapstructure:"store_rollback"`
+		OrderPaid     string `mapstructure:"order_paid"`
 	}
 }
 
+type ShippingConfig struct {
+	Fee           int64 `mapstructure:"fee"`            // 运费（分）
+	FreeThreshold int64 `mapstructure:"free_threshold"` // 包邮门槛（分），0表示不包邮
+}
+
+// FulfilmentConfig 发货和物流，包裹全部签收ConfirmDays天后自动确认收货
+type FulfilmentConfig struct {
+	ConfirmDays       int    `mapstructure:"confirm_days"`
+	Interval          int    `mapstructure:"interval"`            // 自动确认收货的扫描间隔（秒）
+	FakeCarrier       bool   `mapstructure:"fake_carrier"`        // 是否注册测试承运商，只在开发、测试环境开启
+	FakeCarrierSecret string `mapstructure:"fake_carrier_secret"` // 测试承运商推送轨迹的签名密钥，通过环境变量ORDER_FAKE_CARRIER_SECRET设置
+}
+
+type QuoteConfig struct {
+	Secret string `mapstructure:"secret"` // 报价凭证签名密钥，通过环境变量ORDER_QUOTE_SECRET设置
+	Expire int64  `mapstructure:"expire"` // 报价有效期（秒）
+}
+
+// secretEnv 密钥不写入配置文件，从环境变量读取
+var secretEnv = map[string]string{
+	"auth.secret":                    "AUTH_SECRET",
+	"auth.service_secret":            "SERVICE_AUTH_SECRET",
+	"quote.secret":                   "ORDER_QUOTE_SECRET",
+	"fulfilment.fake_carrier_secret": "ORDER_FAKE_CARRIER_SECRET",
+}
+
 func Init(filePath string) (err error) {
 	viper.SetConfigFile(filePath)
+	for key, env := range secretEnv {
+		if err = viper.BindEnv(key, env); err != nil {
+			return
+		}
+	}
 
 	err = viper.ReadInConfig()
 	if err != nil {
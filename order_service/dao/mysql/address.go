@@ -0,0 +1,171 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"order_service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAddressNotFound = errors.New("收货地址不存在")
+	ErrAddressLimit    = errors.New("收货地址数量已达上限")
+)
+
+// CreateAddress 新增收货地址，用户没有地址时新地址为默认地址
+// 锁定用户已有的地址，避免并发新增超过上限或出现多个默认地址
+func CreateAddress(ctx context.Context, data *model.Address, limit int) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var list []*model.Address
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("user_id = ? and is_del = 0", data.UserId).
+				Find(&list).Error
+			if err != nil {
+				return err
+			}
+			if len(list) >= limit {
+				return ErrAddressLimit
+			}
+			if len(list) == 0 {
+				data.IsDefault = true
+			}
+			if data.IsDefault {
+				if err := clearDefaultAddress(tx, data.UserId); err != nil {
+					return err
+				}
+			}
+			return tx.Create(data).Error
+		})
+}
+
+// UpdateAddress 修改收货地址，IsDefault为true时设为默认，不能通过修改取消默认
+func UpdateAddress(ctx context.Context, data *model.Address) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			old, err := lockAddress(tx, data.UserId, data.AddressId)
+			if err != nil {
+				return err
+			}
+			if data.IsDefault && !old.IsDefault {
+				if err := clearDefaultAddress(tx, data.UserId); err != nil {
+					return err
+				}
+			}
+			data.IsDefault = data.IsDefault || old.IsDefault
+			return tx.Model(&model.Address{}).
+				Where("address_id = ?", data.AddressId).
+				Updates(map[string]interface{}{
+					"name":        data.Name,
+					"phone":       data.Phone,
+					"region_code": data.RegionCode,
+					"province":    data.Province,
+					"city":        data.City,
+					"district":    data.District,
+					"detail":      data.Detail,
+					"is_default":  data.IsDefault,
+					"update_by":   data.UpdateBy,
+				}).Error
+		})
+}
+
+// DeleteAddress 删除收货地址，删除的是默认地址时最近添加的地址成为默认
+func DeleteAddress(ctx context.Context, userId, addressId int64) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			old, err := lockAddress(tx, userId, addressId)
+			if err != nil {
+				return err
+			}
+			err = tx.Model(&model.Address{}).
+				Where("address_id = ?", addressId).
+				Updates(map[string]interface{}{
+					"is_del":     1,
+					"is_default": false,
+				}).Error
+			if err != nil {
+				return err
+			}
+			if !old.IsDefault {
+				return nil
+			}
+			var latest model.Address
+			err = tx.Where("user_id = ? and is_del = 0", userId).
+				Order("id desc").
+				Limit(1).
+				Find(&latest).Error
+			if err != nil || latest.ID == 0 {
+				return err
+			}
+			return tx.Model(&model.Address{}).
+				Where("address_id = ?", latest.AddressId).
+				Update("is_default", true).Error
+		})
+}
+
+// SetDefaultAddress 设为默认地址，用户的其他地址取消默认
+func SetDefaultAddress(ctx context.Context, userId, addressId int64) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			if _, err := lockAddress(tx, userId, addressId); err != nil {
+				return err
+			}
+			if err := clearDefaultAddress(tx, userId); err != nil {
+				return err
+			}
+			return tx.Model(&model.Address{}).
+				Where("address_id = ?", addressId).
+				Update("is_default", true).Error
+		})
+}
+
+// GetAddress 查询用户自己的收货地址，不是自己的按不存在处理
+func GetAddress(ctx context.Context, userId, addressId int64) (*model.Address, error) {
+	var data model.Address
+	err := db.WithContext(ctx).
+		Where("address_id = ? and user_id = ? and is_del = 0", addressId, userId).
+		First(&data).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetAddressList 用户的收货地址，默认地址在最前，其余按添加时间倒序
+func GetAddressList(ctx context.Context, userId int64) ([]*model.Address, error) {
+	var data []*model.Address
+	err := db.WithContext(ctx).
+		Model(&model.Address{}).
+		Where("user_id = ? and is_del = 0", userId).
+		Order("is_default desc, id desc").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+func lockAddress(tx *gorm.DB, userId, addressId int64) (*model.Address, error) {
+	var data model.Address
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("address_id = ? and user_id = ? and is_del = 0", addressId, userId).
+		First(&data).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func clearDefaultAddress(tx *gorm.DB, userId int64) error {
+	return tx.Model(&model.Address{}).
+		Where("user_id = ? and is_default = 1", userId).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,48 @@
+package mysql
+
+import (
+	"context"
+	"order_service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func GetCartList(ctx context.Context, userId int64) ([]*model.Cart, error) {
+	var data []*model.Cart
+	err := db.WithContext(ctx).
+		Model(&model.Cart{}).
+		Where("user_id = ?", userId).
+		Order("id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SaveCart 保存购物车商品，同一用户同一商品的同一规格只有一行
+func SaveCart(ctx context.Context, data *model.Cart) error {
+	return db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "goods_id"}, {Name: "sku_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"room_id", "num", "selected", "update_at"}),
+		}).
+		Create(data).Error
+}
+
+// DeleteCart 删除购物车商品，goodsIds删除商品的所有规格，skuIds删除指定规格
+func DeleteCart(ctx context.Context, userId int64, goodsIds, skuIds []int64) error {
+	query := db.WithContext(ctx).Where("user_id = ?", userId)
+	switch {
+	case len(goodsIds) > 0 && len(skuIds) > 0:
+		query = query.Where("goods_id in ? or sku_id in ?", goodsIds, skuIds)
+	case len(goodsIds) > 0:
+		query = query.Where("goods_id in ?", goodsIds)
+	case len(skuIds) > 0:
+		query = query.Where("sku_id in ?", skuIds)
+	default:
+		return nil
+	}
+	return query.Delete(&model.Cart{}).Error
+}
@@ -0,0 +1,145 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"order_service/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCouponSoldOut   = errors.New("优惠券已领完")
+	ErrCouponLimit     = errors.New("超过每人限领数量")
+	ErrCouponNotUnused = errors.New("优惠券不可用")
+)
+
+func CreateCouponTemplate(ctx context.Context, data *model.CouponTemplate) error {
+	return db.WithContext(ctx).Create(data).Error
+}
+
+func GetCouponTemplate(ctx context.Context, templateId int64) (*model.CouponTemplate, error) {
+	var data model.CouponTemplate
+	err := db.WithContext(ctx).
+		Model(&model.CouponTemplate{}).
+		Where("template_id = ?", templateId).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func GetCouponTemplateList(ctx context.Context, idList []int64) ([]*model.CouponTemplate, error) {
+	var data []*model.CouponTemplate
+	err := db.WithContext(ctx).
+		Model(&model.CouponTemplate{}).
+		Where("template_id in ?", idList).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// IssueCoupon 发放优惠券，模板发放数量和每人限领在同一个事务中校验
+// 先锁住模板行，同一模板的发放串行执行，避免并发领取时超过每人限领数量
+func IssueCoupon(ctx context.Context, tpl *model.CouponTemplate, data *model.UserCoupon) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked model.CouponTemplate
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Model(&model.CouponTemplate{}).
+			Where("template_id = ?", tpl.TemplateId).
+			First(&locked).Error
+		if err != nil {
+			return err
+		}
+
+		if tpl.PerUserLimit > 0 {
+			var count int64
+			err := tx.Model(&model.UserCoupon{}).
+				Where("user_id = ? and template_id = ?", data.UserId, tpl.TemplateId).
+				Count(&count).Error
+			if err != nil {
+				return err
+			}
+			if count >= int64(tpl.PerUserLimit) {
+				return ErrCouponLimit
+			}
+		}
+
+		res := tx.Model(&model.CouponTemplate{}).
+			Where("template_id = ? and issued < total", tpl.TemplateId).
+			Update("issued", gorm.Expr("issued + 1"))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < 1 {
+			return ErrCouponSoldOut
+		}
+		return tx.Create(data).Error
+	})
+}
+
+func GetUserCoupon(ctx context.Context, couponId int64) (*model.UserCoupon, error) {
+	var data model.UserCoupon
+	err := db.WithContext(ctx).
+		Model(&model.UserCoupon{}).
+		Where("coupon_id = ?", couponId).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetUserCouponList 查询用户优惠券，status小于0时查询全部
+func GetUserCouponList(ctx context.Context, userId int64, status int32) ([]*model.UserCoupon, error) {
+	var data []*model.UserCoupon
+	query := db.WithContext(ctx).
+		Model(&model.UserCoupon{}).
+		Where("user_id = ?", userId)
+	if status >= 0 {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("id desc").Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// lockCoupon 下单时锁定优惠券，只有未使用的券才能锁定
+func lockCoupon(tx *gorm.DB, couponId, userId, orderId int64) error {
+	res := tx.Model(&model.UserCoupon{}).
+		Where("coupon_id = ? and user_id = ? and status = ?", couponId, userId, model.CouponStatusUnused).
+		Updates(map[string]interface{}{
+			"status":   model.CouponStatusLocked,
+			"order_id": orderId,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return ErrCouponNotUnused
+	}
+	return nil
+}
+
+// releaseCoupon 订单关闭时释放锁定的优惠券
+func releaseCoupon(tx *gorm.DB, orderId int64) error {
+	return tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? and status = ?", orderId, model.CouponStatusLocked).
+		Updates(map[string]interface{}{
+			"status":   model.CouponStatusUnused,
+			"order_id": 0,
+		}).Error
+}
+
+// useCoupon 订单支付后核销锁定的优惠券
+func useCoupon(tx *gorm.DB, orderId int64) error {
+	return tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? and status = ?", orderId, model.CouponStatusLocked).
+		Update("status", model.CouponStatusUsed).Error
+}
@@ -1,10 +1,12 @@
 package mysql
 
 import (
+	"errors"
 	"fmt"
 	"order_service/config"
 	"time"
 
+	driver "github.com/go-sql-driver/mysql"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -30,3 +32,9 @@ func Init(cfg *config.MySQLConfig) (err error) {
 	zap.L().Info("Init MySQL Success!")
 	return
 }
+
+// isDuplicate 是否违反唯一索引
+func isDuplicate(err error) bool {
+	var mysqlErr *driver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
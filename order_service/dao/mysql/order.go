@@ -2,7 +2,9 @@ package mysql
 
 import (
 	"context"
+	"errors"
 	"order_service/model"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -28,17 +30,132 @@ func CreateOrderDetail(ctx context.Context, data *model.OrderDetail) error {
 	return db.WithContext(ctx).Model(&model.OrderDetail{}).Save(data).Error
 }
 
-func CreateOrderWithTransation(ctx context.Context, order *model.Order, orderDetail *model.OrderDetail) error {
+func CreateOrderWithTransation(ctx context.Context, order *model.Order, orderDetails []*model.OrderDetail) error {
 	return db.WithContext(ctx).
 		Transaction(func(tx *gorm.DB) error {
 			if err := tx.Create(order).Error; err != nil {
 				return err
 			}
 
-			if err := tx.Create(orderDetail).Error; err != nil {
+			// 锁定下单使用的优惠券
+			if order.CouponId > 0 {
+				if err := lockCoupon(tx, order.CouponId, order.UserId, order.OrderId); err != nil {
+					return err
+				}
+			}
+
+			if err := tx.Create(orderDetails).Error; err != nil {
 				return err
 			}
 
 			return nil
 		})
 }
+
+var ErrOrderStatus = errors.New("订单状态不允许该操作")
+
+func GetOrderDetailList(ctx context.Context, orderId int64) ([]*model.OrderDetail, error) {
+	var data []*model.OrderDetail
+	err := db.WithContext(ctx).
+		Model(&model.OrderDetail{}).
+		Where("order_id = ?", orderId).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ListOrders 分页查询用户的订单，按下单时间倒序
+func ListOrders(ctx context.Context, userId int64, offset, limit int) ([]*model.Order, int64, error) {
+	query := db.WithContext(ctx).
+		Model(&model.Order{}).
+		Where("user_id = ? and is_del = 0", userId)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var data []*model.Order
+	err := query.Order("id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+// GetOrderDetailsByOrderIds 批量查询多个订单的商品行
+func GetOrderDetailsByOrderIds(ctx context.Context, idList []int64) ([]*model.OrderDetail, error) {
+	var data []*model.OrderDetail
+	err := db.WithContext(ctx).
+		Model(&model.OrderDetail{}).
+		Where("order_id in ?", idList).
+		Order("id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// CloseOrder 关闭待支付的订单并释放锁定的优惠券
+func CloseOrder(ctx context.Context, orderId int64) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			res := tx.Model(&model.Order{}).
+				Where("order_id = ? and status = ?", orderId, model.OrderStatusUnpaid).
+				Update("status", model.OrderStatusClosed)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected < 1 {
+				return ErrOrderStatus
+			}
+			return releaseCoupon(tx, orderId)
+		})
+}
+
+// PayOrder 待支付订单变更为已支付并核销优惠券，订单商品行同时记录支付时间
+func PayOrder(ctx context.Context, orderId int64) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			now := time.Now()
+			res := tx.Model(&model.Order{}).
+				Where("order_id = ? and status = ?", orderId, model.OrderStatusUnpaid).
+				Updates(map[string]interface{}{
+					"status":   model.OrderStatusPaid,
+					"pay_time": now,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected < 1 {
+				return ErrOrderStatus
+			}
+			err := tx.Model(&model.OrderDetail{}).
+				Where("order_id = ?", orderId).
+				Update("pay_time", now).Error
+			if err != nil {
+				return err
+			}
+			return useCoupon(tx, orderId)
+		})
+}
+
+// FinishOrder 已支付或已发货的订单变更为完成
+func FinishOrder(ctx context.Context, orderId int64) error {
+	res := db.WithContext(ctx).
+		Model(&model.Order{}).
+		Where("order_id = ? and status in ?", orderId, []int32{model.OrderStatusPaid, model.OrderStatusShipped}).
+		Update("status", model.OrderStatusFinished)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return ErrOrderStatus
+	}
+	return nil
+}
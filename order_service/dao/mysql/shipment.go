@@ -0,0 +1,204 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"order_service/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrLineShipped      = errors.New("商品行已发货")
+	ErrTrackingNoExists = errors.New("运单号已存在")
+)
+
+// CreateShipment 已支付的订单发出一个包裹，商品行记录所在的包裹，所有商品行都发货后订单变更为已发货
+// 锁定订单行，避免并发发货时同一商品行进入多个包裹
+func CreateShipment(ctx context.Context, data *model.Shipment, detailIds []uint) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var o model.Order
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("order_id = ?", data.OrderId).
+				First(&o).Error
+			if err != nil {
+				return err
+			}
+			if o.Status != model.OrderStatusPaid {
+				return ErrOrderStatus
+			}
+
+			res := tx.Model(&model.OrderDetail{}).
+				Where("id in ? and order_id = ? and shipment_id = 0", detailIds, data.OrderId).
+				Update("shipment_id", data.ShipmentId)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected != int64(len(detailIds)) {
+				return ErrLineShipped
+			}
+			if err := tx.Create(data).Error; err != nil {
+				if isDuplicate(err) {
+					return ErrTrackingNoExists
+				}
+				return err
+			}
+
+			var left int64
+			err = tx.Model(&model.OrderDetail{}).
+				Where("order_id = ? and shipment_id = 0", data.OrderId).
+				Count(&left).Error
+			if err != nil {
+				return err
+			}
+			if left > 0 {
+				return nil
+			}
+			return tx.Model(&model.Order{}).
+				Where("order_id = ?", data.OrderId).
+				Update("status", model.OrderStatusShipped).Error
+		})
+}
+
+// GetShipmentList 订单的所有包裹，按发货顺序
+func GetShipmentList(ctx context.Context, orderId int64) ([]*model.Shipment, error) {
+	var data []*model.Shipment
+	err := db.WithContext(ctx).
+		Model(&model.Shipment{}).
+		Where("order_id = ? and is_del = 0", orderId).
+		Order("id").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetShipmentByTrackingNo 按承运商和运单号查询包裹
+func GetShipmentByTrackingNo(ctx context.Context, carrier, trackingNo string) (*model.Shipment, error) {
+	var data model.Shipment
+	err := db.WithContext(ctx).
+		Where("carrier = ? and tracking_no = ? and is_del = 0", carrier, trackingNo).
+		First(&data).Error
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetShipmentEvents 批量查询包裹的物流轨迹，按时间倒序
+func GetShipmentEvents(ctx context.Context, idList []int64) ([]*model.ShipmentEvent, error) {
+	var data []*model.ShipmentEvent
+	err := db.WithContext(ctx).
+		Model(&model.ShipmentEvent{}).
+		Where("shipment_id in ?", idList).
+		Order("event_time desc, id desc").
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, err
+	}
+	return data, nil
+}
+
+// AddShipmentEvents 记录包裹的物流轨迹并更新包裹状态，重复推送的轨迹忽略
+// 已签收是终态，之后的轨迹只记录不改状态；订单的所有包裹都签收后记录订单的签收时间
+func AddShipmentEvents(ctx context.Context, shipmentId int64, events []*model.ShipmentEvent) error {
+	return db.WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var data model.Shipment
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("shipment_id = ?", shipmentId).
+				First(&data).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(events).Error
+			if err != nil {
+				return err
+			}
+			if data.Status == model.ShipmentDelivered {
+				return nil
+			}
+
+			// 推送可能乱序，以签收轨迹或时间最新的轨迹为准
+			var delivered model.ShipmentEvent
+			err = tx.Where("shipment_id = ? and status = ?", shipmentId, model.ShipmentDelivered).
+				Order("event_time").
+				Limit(1).
+				Find(&delivered).Error
+			if err != nil {
+				return err
+			}
+			updates := map[string]interface{}{}
+			if delivered.ID > 0 {
+				updates["status"] = model.ShipmentDelivered
+				updates["delivered_at"] = delivered.EventTime
+			} else {
+				var latest model.ShipmentEvent
+				err = tx.Where("shipment_id = ?", shipmentId).
+					Order("event_time desc, id desc").
+					Limit(1).
+					Find(&latest).Error
+				if err != nil {
+					return err
+				}
+				if latest.ID == 0 || latest.Status == data.Status {
+					return nil
+				}
+				updates["status"] = latest.Status
+			}
+			err = tx.Model(&model.Shipment{}).
+				Where("shipment_id = ?", shipmentId).
+				Updates(updates).Error
+			if err != nil {
+				return err
+			}
+			if delivered.ID == 0 {
+				return nil
+			}
+			return markOrderDelivered(tx, data.OrderId, delivered.EventTime)
+		})
+}
+
+// markOrderDelivered 已发货订单的所有包裹都签收后记录签收时间，以最后一个包裹的签收时间为准
+func markOrderDelivered(tx *gorm.DB, orderId int64, deliveredAt time.Time) error {
+	var pending int64
+	err := tx.Model(&model.Shipment{}).
+		Where("order_id = ? and is_del = 0 and status <> ?", orderId, model.ShipmentDelivered).
+		Count(&pending).Error
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return nil
+	}
+	var last model.Shipment
+	err = tx.Where("order_id = ? and is_del = 0", orderId).
+		Order("delivered_at desc").
+		Limit(1).
+		Find(&last).Error
+	if err != nil {
+		return err
+	}
+	if last.DeliveredAt != nil && last.DeliveredAt.After(deliveredAt) {
+		deliveredAt = *last.DeliveredAt
+	}
+	return tx.Model(&model.Order{}).
+		Where("order_id = ? and status = ? and delivered_at is null", orderId, model.OrderStatusShipped).
+		Update("delivered_at", deliveredAt).Error
+}
+
+// GetDeliveredOrderIds 签收时间早于before、还未确认收货的订单
+func GetDeliveredOrderIds(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	var idList []int64
+	err := db.WithContext(ctx).
+		Model(&model.Order{}).
+		Where("status = ? and delivered_at <= ?", model.OrderStatusShipped, before).
+		Order("delivered_at").
+		Limit(limit).
+		Pluck("order_id", &idList).Error
+	return idList, err
+}
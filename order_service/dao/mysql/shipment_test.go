@@ -0,0 +1,160 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"order_service/model"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDB(t *testing.T) sqlmock.Sqlmock {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := db
+	db, err = gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db = old
+		sqlDB.Close()
+	})
+	return mock
+}
+
+var shipmentColumns = []string{"id", "shipment_id", "order_id", "status", "delivered_at"}
+
+var eventColumns = []string{"id", "shipment_id", "status", "event_time"}
+
+func TestAddShipmentEvents(t *testing.T) {
+	shippedAt := time.Unix(1700000000, 0)
+	deliveredAt := shippedAt.Add(24 * time.Hour)
+	events := func() []*model.ShipmentEvent {
+		return []*model.ShipmentEvent{{ShipmentId: 101, Status: model.ShipmentDelivered, EventTime: deliveredAt}}
+	}
+
+	tests := []struct {
+		name   string
+		expect func(mock sqlmock.Sqlmock)
+		events []*model.ShipmentEvent
+	}{
+		{
+			// 已签收的包裹只记录轨迹，状态不再变化
+			name: "already delivered",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE shipment_id = \\?.* FOR UPDATE").
+					WithArgs(101).
+					WillReturnRows(sqlmock.NewRows(shipmentColumns).AddRow(1, 101, 9, model.ShipmentDelivered, deliveredAt))
+				mock.ExpectExec("INSERT INTO `xx_shipment_event`").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			events: events(),
+		},
+		{
+			// 签收后订单的包裹都已签收，记录订单的签收时间
+			name: "delivered",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE shipment_id = \\?.* FOR UPDATE").
+					WithArgs(101).
+					WillReturnRows(sqlmock.NewRows(shipmentColumns).AddRow(1, 101, 9, model.ShipmentInTransit, nil))
+				mock.ExpectExec("INSERT INTO `xx_shipment_event`").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment_event` WHERE shipment_id = \\? and status = \\?").
+					WithArgs(101, model.ShipmentDelivered).
+					WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(1, 101, model.ShipmentDelivered, deliveredAt))
+				mock.ExpectExec("UPDATE `xx_shipment` SET .*`delivered_at`=\\?.*`status`=\\?").
+					WithArgs(deliveredAt, model.ShipmentDelivered, sqlmock.AnyArg(), 101).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM `xx_shipment` WHERE order_id = \\?").
+					WithArgs(9, model.ShipmentDelivered).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE order_id = \\? and is_del = 0 ORDER BY delivered_at desc").
+					WithArgs(9).
+					WillReturnRows(sqlmock.NewRows(shipmentColumns).AddRow(1, 101, 9, model.ShipmentDelivered, deliveredAt))
+				mock.ExpectExec("UPDATE `xx_order` SET `delivered_at`=\\?").
+					WithArgs(deliveredAt, sqlmock.AnyArg(), 9, model.OrderStatusShipped).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			events: events(),
+		},
+		{
+			// 订单还有未签收的包裹，不记录订单的签收时间
+			name: "other shipment pending",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE shipment_id = \\?.* FOR UPDATE").
+					WithArgs(101).
+					WillReturnRows(sqlmock.NewRows(shipmentColumns).AddRow(1, 101, 9, model.ShipmentInTransit, nil))
+				mock.ExpectExec("INSERT INTO `xx_shipment_event`").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment_event` WHERE shipment_id = \\? and status = \\?").
+					WithArgs(101, model.ShipmentDelivered).
+					WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(1, 101, model.ShipmentDelivered, deliveredAt))
+				mock.ExpectExec("UPDATE `xx_shipment` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM `xx_shipment` WHERE order_id = \\?").
+					WithArgs(9, model.ShipmentDelivered).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+			events: events(),
+		},
+		{
+			// 乱序推送的旧轨迹不改变包裹状态
+			name: "stale event",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE shipment_id = \\?.* FOR UPDATE").
+					WithArgs(101).
+					WillReturnRows(sqlmock.NewRows(shipmentColumns).AddRow(1, 101, 9, model.ShipmentException, nil))
+				mock.ExpectExec("INSERT INTO `xx_shipment_event`").WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment_event` WHERE shipment_id = \\? and status = \\?").
+					WithArgs(101, model.ShipmentDelivered).
+					WillReturnRows(sqlmock.NewRows(eventColumns))
+				mock.ExpectQuery("SELECT \\* FROM `xx_shipment_event` WHERE shipment_id = \\? ORDER BY event_time desc, id desc").
+					WithArgs(101).
+					WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(1, 101, model.ShipmentException, deliveredAt))
+				mock.ExpectCommit()
+			},
+			events: []*model.ShipmentEvent{{ShipmentId: 101, Status: model.ShipmentInTransit, EventTime: shippedAt}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockDB(t)
+			tt.expect(mock)
+			if err := AddShipmentEvents(context.Background(), 101, tt.events); err != nil {
+				t.Fatalf("AddShipmentEvents() err = %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAddShipmentEventsNotFound(t *testing.T) {
+	mock := newMockDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `xx_shipment` WHERE shipment_id = \\?.* FOR UPDATE").
+		WithArgs(404).
+		WillReturnRows(sqlmock.NewRows(shipmentColumns))
+	mock.ExpectRollback()
+
+	events := []*model.ShipmentEvent{{ShipmentId: 404, Status: model.ShipmentInTransit, EventTime: time.Now()}}
+	if err := AddShipmentEvents(context.Background(), 404, events); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("AddShipmentEvents() err = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
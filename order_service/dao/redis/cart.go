@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"order_service/model"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 购物车缓存过期时间，过期后从MySQL重新加载
+const cartExpire = 7 * 24 * time.Hour
+
+func cartKey(userId int64) string {
+	return fmt.Sprintf("xx_cart:%d", userId)
+}
+
+// GetCart 读取用户购物车，ok为false表示缓存中没有该用户的购物车
+func GetCart(ctx context.Context, userId int64) (data []*model.Cart, ok bool, err error) {
+	res, err := Rdb.HGetAll(ctx, cartKey(userId)).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res) == 0 {
+		return nil, false, nil
+	}
+	data = make([]*model.Cart, 0, len(res))
+	for field, v := range res {
+		// 占位字段表示空购物车
+		if field == "0" {
+			continue
+		}
+		var item model.Cart
+		if err := json.Unmarshal([]byte(v), &item); err != nil {
+			return nil, false, err
+		}
+		data = append(data, &item)
+	}
+	return data, true, nil
+}
+
+// SetCart 用MySQL中的购物车数据重建缓存
+func SetCart(ctx context.Context, userId int64, data []*model.Cart) error {
+	key := cartKey(userId)
+	values := make([]interface{}, 0, 2*len(data)+2)
+	// 空购物车写入占位字段，避免每次都回源MySQL
+	values = append(values, "0", "")
+	for _, item := range data {
+		b, _ := json.Marshal(item)
+		values = append(values, fmt.Sprintf("%d:%d", item.GoodsId, item.SkuId), b)
+	}
+	_, err := Rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, values...)
+		pipe.Expire(ctx, key, cartExpire)
+		return nil
+	})
+	return err
+}
+
+// DelCart 删除购物车缓存，下次读取时从MySQL重建
+func DelCart(ctx context.Context, userId int64) error {
+	return Rdb.Del(ctx, cartKey(userId)).Err()
+}
@@ -0,0 +1,20 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func quoteKey(id string) string {
+	return fmt.Sprintf("xx_quote_used:%s", id)
+}
+
+// UseQuote 标记报价凭证已使用，标记保留到凭证过期，返回false表示凭证已经用过
+func UseQuote(ctx context.Context, id string, expireAt time.Time) (bool, error) {
+	ttl := time.Until(expireAt)
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	return Rdb.SetNX(ctx, quoteKey(id), 1, ttl).Result()
+}
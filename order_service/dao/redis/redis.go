@@ -11,7 +11,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var Rs *redsync.Redsync
+var (
+	Rs  *redsync.Redsync
+	Rdb *redis.Client
+)
 
 func Init(cfg *config.RedisConfig) error {
 	rc := redis.NewClient(&redis.Options{
@@ -27,6 +30,7 @@ func Init(cfg *config.RedisConfig) error {
 	}
 
 	zap.L().Info("Init Redis Success!")
+	Rdb = rc
 
 	pool := goredis.NewPool(rc)
 
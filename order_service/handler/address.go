@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"order_service/biz/address"
+	"order_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AddressSrv struct {
+	proto.UnimplementedAddressServer
+}
+
+// addressError 将收货地址业务错误转换为gRPC错误
+func addressError(err error) error {
+	if errors.Is(err, address.ErrInvalidName) || errors.Is(err, address.ErrInvalidPhone) ||
+		errors.Is(err, address.ErrInvalidRegion) || errors.Is(err, address.ErrInvalidDetail) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, address.ErrAddressNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, address.ErrAddressLimit) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "内部错误")
+}
+
+// CreateAddress 新增收货地址
+func (s *AddressSrv) CreateAddress(ctx context.Context, req *proto.AddressInfo) (*proto.AddressInfo, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	data, err := address.Create(ctx, req)
+	if err != nil {
+		zap.L().Error("address.Create failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, addressError(err)
+	}
+	return data, nil
+}
+
+// UpdateAddress 修改收货地址
+func (s *AddressSrv) UpdateAddress(ctx context.Context, req *proto.AddressInfo) (*proto.AddressInfo, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetAddressId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := address.Update(ctx, req)
+	if err != nil {
+		zap.L().Error("address.Update failed", zap.Int64("user_id", req.GetUserId()), zap.Int64("address_id", req.GetAddressId()), zap.Error(err))
+		return nil, addressError(err)
+	}
+	return data, nil
+}
+
+// DeleteAddress 删除收货地址
+func (s *AddressSrv) DeleteAddress(ctx context.Context, req *proto.AddressIdReq) (*proto.AddressListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetAddressId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := address.Delete(ctx, req.GetUserId(), req.GetAddressId())
+	if err != nil {
+		zap.L().Error("address.Delete failed", zap.Int64("user_id", req.GetUserId()), zap.Int64("address_id", req.GetAddressId()), zap.Error(err))
+		return nil, addressError(err)
+	}
+	return data, nil
+}
+
+// SetDefaultAddress 设为默认地址
+func (s *AddressSrv) SetDefaultAddress(ctx context.Context, req *proto.AddressIdReq) (*proto.AddressListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetAddressId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := address.SetDefault(ctx, req.GetUserId(), req.GetAddressId())
+	if err != nil {
+		zap.L().Error("address.SetDefault failed", zap.Int64("user_id", req.GetUserId()), zap.Int64("address_id", req.GetAddressId()), zap.Error(err))
+		return nil, addressError(err)
+	}
+	return data, nil
+}
+
+// AddressList 收货地址列表
+func (s *AddressSrv) AddressList(ctx context.Context, req *proto.AddressListReq) (*proto.AddressListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	data, err := address.List(ctx, req.GetUserId())
+	if err != nil {
+		zap.L().Error("address.List failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, addressError(err)
+	}
+	return data, nil
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"order_service/biz/cart"
+	"order_service/biz/order"
+	"order_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CartSrv struct {
+	proto.UnimplementedCartServer
+}
+
+// cartError 将购物车业务错误转换为gRPC错误
+func cartError(err error) error {
+	if errors.Is(err, cart.ErrGoodsOffShelf) || errors.Is(err, cart.ErrStockNotEnough) || errors.Is(err, cart.ErrNothingSelected) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, cart.ErrCartItemNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, order.ErrSkuRequired) || errors.Is(err, order.ErrSkuNotFound) || errors.Is(err, order.ErrGoodsNotFound) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// 下游服务返回的参数类错误直接透传
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Internal {
+		return err
+	}
+	return status.Error(codes.Internal, "内部错误")
+}
+
+// AddCart 加入购物车
+func (s *CartSrv) AddCart(ctx context.Context, req *proto.CartItemReq) (*proto.CartListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetGoodsId() <= 0 || req.GetNum() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := cart.Add(ctx, req)
+	if err != nil {
+		zap.L().Error("cart.Add failed", zap.Int64("user_id", req.GetUserId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, cartError(err)
+	}
+	return data, nil
+}
+
+// UpdateCart 修改购物车商品
+func (s *CartSrv) UpdateCart(ctx context.Context, req *proto.CartItemReq) (*proto.CartListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetGoodsId() <= 0 || req.GetNum() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := cart.Update(ctx, req)
+	if err != nil {
+		zap.L().Error("cart.Update failed", zap.Int64("user_id", req.GetUserId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, cartError(err)
+	}
+	return data, nil
+}
+
+// RemoveCart 删除购物车商品
+func (s *CartSrv) RemoveCart(ctx context.Context, req *proto.RemoveCartReq) (*proto.CartListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if len(req.GetGoodsIds()) == 0 && len(req.GetSkuIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := cart.Remove(ctx, req.GetUserId(), req.GetGoodsIds(), req.GetSkuIds())
+	if err != nil {
+		zap.L().Error("cart.Remove failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, cartError(err)
+	}
+	return data, nil
+}
+
+// CartList 购物车列表
+func (s *CartSrv) CartList(ctx context.Context, req *proto.CartListReq) (*proto.CartListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	data, err := cart.List(ctx, req.GetUserId())
+	if err != nil {
+		zap.L().Error("cart.List failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, cartError(err)
+	}
+	return data, nil
+}
+
+// Checkout 购物车结算下单
+func (s *CartSrv) Checkout(ctx context.Context, req *proto.CheckoutReq) (*proto.OrderBaseResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetAddressId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := cart.Checkout(ctx, req)
+	if err != nil {
+		zap.L().Error("cart.Checkout failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, cartError(err)
+	}
+	return data, nil
+}
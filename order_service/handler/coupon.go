@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"order_service/biz/promotion"
+	"order_service/dao/mysql"
+	"order_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCouponTemplate 创建优惠券模板
+func (s *OrderSrv) CreateCouponTemplate(ctx context.Context, req *proto.CouponTemplateInfo) (*proto.CouponTemplateInfo, error) {
+	data, err := promotion.CreateTemplate(ctx, req)
+	if errors.Is(err, promotion.ErrInvalidTemplate) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("promotion.CreateTemplate failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// IssueCoupon 给用户发放优惠券
+func (s *OrderSrv) IssueCoupon(ctx context.Context, req *proto.IssueCouponReq) (*proto.UserCouponInfo, error) {
+	if req.GetTemplateId() <= 0 || req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := promotion.Issue(ctx, req.GetTemplateId(), req.GetUserId())
+	if errors.Is(err, promotion.ErrTemplateNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, promotion.ErrTemplateExpired) || errors.Is(err, mysql.ErrCouponSoldOut) || errors.Is(err, mysql.ErrCouponLimit) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("promotion.Issue failed", zap.Int64("template_id", req.GetTemplateId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// UserCouponList 用户优惠券列表
+func (s *OrderSrv) UserCouponList(ctx context.Context, req *proto.UserCouponListReq) (*proto.UserCouponListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+
+	data, err := promotion.UserCouponList(ctx, req.GetUserId(), req.GetStatus())
+	if err != nil {
+		zap.L().Error("promotion.UserCouponList failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
@@ -1,32 +1,64 @@
 package handler
 
 import (
+	"auth"
 	"context"
 	"encoding/json"
+	"errors"
 	"order_service/biz/order"
-	"order_service/config"
-	"order_service/dao/mq"
+	"order_service/biz/promotion"
 	"order_service/dao/mysql"
 	"order_service/model"
 	"order_service/proto"
+	"strconv"
 
 	"github.com/apache/rocketmq-client-go/v2/consumer"
 	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type OrderSrv struct {
 	proto.UnimplementedOrderServer
 }
 
+// authUserId 当前登录的用户，没有登录时返回Unauthenticated
+func authUserId(ctx context.Context) (int64, error) {
+	userId, ok := auth.UserId(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "请先登录")
+	}
+	return userId, nil
+}
+
+// operator 操作人，记录到审计字段，取自登录令牌中的用户，服务间调用时为调用方的服务名
+func operator(ctx context.Context) string {
+	ident, ok := auth.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ident.UserId > 0 {
+		return strconv.FormatInt(ident.UserId, 10)
+	}
+	return ident.Service
+}
+
 func (s *OrderSrv) CreateOrder(ctx context.Context, req *proto.OrderReq) (*proto.OrderBaseResp, error) {
-	if req.GetUserId() <= 0 {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetAddressId() <= 0 || order.Lines(req) == nil {
 		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
 	}
 
 	data, err := order.Create(ctx, req)
+	if code := status.Code(err); code == codes.InvalidArgument || code == codes.FailedPrecondition {
+		return nil, err
+	}
 	if err != nil {
 		zap.L().Error("order.Create failed:", zap.Error(err))
 		return nil, status.Error(codes.Internal, "内部错误")
@@ -35,6 +67,86 @@ func (s *OrderSrv) CreateOrder(ctx context.Context, req *proto.OrderReq) (*proto
 	return data, nil
 }
 
+// PreviewOrder 下单前预览订单金额
+func (s *OrderSrv) PreviewOrder(ctx context.Context, req *proto.OrderReq) (*proto.OrderQuote, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if order.Lines(req) == nil {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := order.Preview(ctx, req)
+	if errors.Is(err, promotion.ErrCouponUnavailable) || errors.Is(err, order.ErrSkuRequired) || errors.Is(err, order.ErrSkuNotFound) || errors.Is(err, order.ErrGoodsNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("order.Preview failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// OrderList 用户的订单列表
+func (s *OrderSrv) OrderList(ctx context.Context, req *proto.OrderListReq) (*proto.OrderListResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+
+	data, err := order.List(ctx, req)
+	if err != nil {
+		zap.L().Error("order.List failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// OrderDetail 订单详情
+func (s *OrderSrv) OrderDetail(ctx context.Context, req *proto.OrderDetailReq) (*proto.OrderDetailInfo, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := order.Detail(ctx, req.GetOrderId(), req.GetUserId())
+	if errors.Is(err, order.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("order.Detail failed", zap.Int64("order_id", req.GetOrderId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// UpdateOrderStatus 更新订单状态：支付、取消、完成
+func (s *OrderSrv) UpdateOrderStatus(ctx context.Context, req *proto.OrderStatus) (*proto.OrderBaseResp, error) {
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := order.UpdateStatus(ctx, req.GetOrderId(), req.GetStatus())
+	if errors.Is(err, mysql.ErrOrderStatus) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "订单不存在")
+	}
+	if err != nil {
+		zap.L().Error("order.UpdateStatus failed", zap.Int64("order_id", req.GetOrderId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
 // 延时消息的处理
 func OrderTimeoutHandle(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 	for i := range msgs {
@@ -49,19 +161,13 @@ func OrderTimeoutHandle(ctx context.Context, msgs ...*primitive.MessageExt) (con
 			zap.L().Error("mysql.QueryOrder failed", zap.Error(err))
 			return consumer.ConsumeRetryLater, nil // 稍后再试
 		}
-		if o.OrderId == data.OrderId && o.Status == 100 { // 待支付
-			msg := &primitive.Message{
-				Topic: config.Conf.RocketMqConfig.Topic.StoreRollback,
-				Body:  msgs[i].Body,
-			}
-			_, err = mq.Producer.SendSync(context.Background(), msg)
-			if err != nil {
-				zap.L().Error("send rollback msg failed", zap.Error(err))
+		if o.OrderId == data.OrderId && o.Status == model.OrderStatusUnpaid { // 待支付
+			// 关闭订单成功后才投递回滚库存的消息，期间订单被支付时不回滚
+			err = order.Cancel(ctx, o.OrderId)
+			if err != nil && !errors.Is(err, mysql.ErrOrderStatus) {
+				zap.L().Error("order.Cancel failed", zap.Int64("order_id", o.OrderId), zap.Error(err))
 				return consumer.ConsumeRetryLater, nil // 稍后再试
 			}
-			// 发送回滚库存成功，将订单状态设置为关闭
-			o.Status = 300
-			mysql.UpdateOrder(ctx, o)
 		}
 	}
 	return consumer.ConsumeSuccess, nil
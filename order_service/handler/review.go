@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"order_service/biz/order"
+	"order_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReviewOrderGoods 评价已完成订单中的商品
+func (s *OrderSrv) ReviewOrderGoods(ctx context.Context, req *proto.CreateReviewReq) (*proto.ReviewInfo, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetOrderId() <= 0 || req.GetGoodsId() <= 0 || req.GetSkuId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := order.Review(ctx, req)
+	if errors.Is(err, order.ErrOrderNotFound) || errors.Is(err, order.ErrLineNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, order.ErrOrderNotFinished) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// 商品服务的参数校验和重复评价直接返回
+	if code := status.Code(err); code == codes.InvalidArgument || code == codes.AlreadyExists || code == codes.NotFound {
+		return nil, err
+	}
+	if err != nil {
+		zap.L().Error("order.Review failed", zap.Int64("order_id", req.GetOrderId()), zap.Int64("goods_id", req.GetGoodsId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
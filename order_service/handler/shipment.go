@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"order_service/biz/order"
+	"order_service/biz/shipment"
+	"order_service/dao/mysql"
+	"order_service/proto"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateShipment 订单发货
+func (s *OrderSrv) CreateShipment(ctx context.Context, req *proto.CreateShipmentReq) (*proto.ShipmentInfo, error) {
+	if req.GetOrderId() <= 0 || req.GetCarrier() == "" || req.GetTrackingNo() == "" || len(req.GetTrackingNo()) > 64 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := shipment.Create(ctx, req, operator(ctx))
+	if errors.Is(err, shipment.ErrCarrierNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, order.ErrOrderNotFound) || errors.Is(err, order.ErrLineNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, mysql.ErrOrderStatus) || errors.Is(err, mysql.ErrLineShipped) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, mysql.ErrTrackingNoExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("shipment.Create failed", zap.Int64("order_id", req.GetOrderId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// ListShipments 订单的包裹和物流轨迹
+func (s *OrderSrv) ListShipments(ctx context.Context, req *proto.ListShipmentsReq) (*proto.ListShipmentsResp, error) {
+	userId, err := authUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userId
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+
+	data, err := shipment.List(ctx, req.GetOrderId(), req.GetUserId())
+	if errors.Is(err, order.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("shipment.List failed", zap.Int64("order_id", req.GetOrderId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// ShipmentWebhookHandler 接收承运商推送的物流轨迹
+// POST /v1/shipment/webhook/<carrier>
+func ShipmentWebhookHandler(prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "仅支持POST", http.StatusMethodNotAllowed)
+			return
+		}
+		carrier, ok := shipment.GetCarrier(strings.TrimPrefix(r.URL.Path, prefix))
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		events, err := carrier.ParseWebhook(r)
+		if errors.Is(err, shipment.ErrInvalidWebhook) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			zap.L().Error("carrier.ParseWebhook failed", zap.String("carrier", carrier.Code()), zap.Error(err))
+			http.Error(w, "内部错误", http.StatusInternalServerError)
+			return
+		}
+		// 写入失败返回5xx，由承运商重试推送
+		if err := shipment.Ingest(r.Context(), carrier.Code(), events); err != nil {
+			zap.L().Error("shipment.Ingest failed", zap.String("carrier", carrier.Code()), zap.Error(err))
+			http.Error(w, "内部错误", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
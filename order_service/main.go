@@ -1,17 +1,21 @@
 package main
 
 import (
+	"auth"
 	"context"
 	"flag"
 	"fmt"
 	"net"
 	"net/http"
+	"order_service/biz/order"
+	"order_service/biz/shipment"
 	"order_service/config"
 	"order_service/dao/mq"
 	"order_service/dao/mysql"
 	"order_service/dao/redis"
 	"order_service/handler"
 	"order_service/logger"
+	"order_service/middleware"
 	"order_service/proto"
 	"order_service/registry"
 	"order_service/rpc"
@@ -71,6 +75,16 @@ func main() {
 		panic(err)
 	}
 
+	// 登录令牌和服务间调用令牌的密钥，要在调用其他服务之前设置
+	err = auth.Init(config.Conf.AuthConfig.Secret)
+	if err != nil {
+		panic(err)
+	}
+	err = auth.InitService(config.Conf.AuthConfig.ServiceSecret)
+	if err != nil {
+		panic(err)
+	}
+
 	// 初始化rpc客户端
 	err = rpc.InitSrvClient()
 	if err != nil {
@@ -87,6 +101,18 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	// 报价凭证的签名密钥
+	err = order.InitQuote(config.Conf.QuoteConfig)
+	if err != nil {
+		panic(err)
+	}
+	// 注册承运商，启动签收后自动确认收货
+	err = shipment.Init(config.Conf.FulfilmentConfig)
+	if err != nil {
+		panic(err)
+	}
+	shipment.StartAutoConfirm(context.Background(), config.Conf.FulfilmentConfig)
+
 	// 消费延时消息，采用push，表示RocketMQ会自动向你推送消息
 	c, err := rocketmq.NewPushConsumer(
 		consumer.WithGroupName("order_srv_1"),
@@ -117,11 +143,18 @@ func main() {
 	}
 
 	// 创建gRPC服务
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryAuth(middleware.Policy)),
+		grpc.ChainStreamInterceptor(auth.StreamAuth(middleware.Policy)),
+	)
 	// 注册健康检查服务，至此consul来对我进行检查
 	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
 	// 商品服务注册RPC服务
 	proto.RegisterOrderServer(s, &handler.OrderSrv{})
+	// 购物车服务
+	proto.RegisterCartServer(s, &handler.CartSrv{})
+	// 收货地址服务
+	proto.RegisterAddressServer(s, &handler.AddressSrv{})
 
 	// 启动gRPC服务
 	go func() {
@@ -151,10 +184,23 @@ func main() {
 	if err != nil {
 		zap.L().Fatal("Failed to register gatewary:", zap.Error(err))
 	}
+	err = proto.RegisterCartHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		zap.L().Fatal("Failed to register gatewary:", zap.Error(err))
+	}
+	err = proto.RegisterAddressHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		zap.L().Fatal("Failed to register gatewary:", zap.Error(err))
+	}
+
+	// 承运商的轨迹推送不走gRPC，其余请求交给网关
+	mux := http.NewServeMux()
+	mux.Handle("/v1/shipment/webhook/", handler.ShipmentWebhookHandler("/v1/shipment/webhook/"))
+	mux.Handle("/", gwmux)
 
 	gwServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Conf.HttpPort),
-		Handler: gwmux,
+		Handler: mux,
 	}
 	zap.L().Sugar().Infof("Serving gRPC-GateWay on http: 0.0.0.0%s", gwServer.Addr)
 
@@ -167,7 +213,7 @@ func main() {
 	}()
 
 	// 服务退出时要注销服务
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
 	// 退出服务时注销服务
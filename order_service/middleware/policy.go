@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"auth"
+	"order_service/proto"
+)
+
+// Policy 订单服务各方法的访问规则，新增方法时需要在这里声明，未声明的方法一律拒绝
+var Policy = auth.Policy{
+	// 买家下单和查询
+	proto.Order_CreateOrder_FullMethodName:      auth.Login,
+	proto.Order_PreviewOrder_FullMethodName:     auth.Login,
+	proto.Order_OrderList_FullMethodName:        auth.Login,
+	proto.Order_OrderDetail_FullMethodName:      auth.Login,
+	proto.Order_ReviewOrderGoods_FullMethodName: auth.Login,
+	proto.Order_ListShipments_FullMethodName:    auth.Login,
+	proto.Order_UserCouponList_FullMethodName:   auth.Login,
+
+	proto.Cart_AddCart_FullMethodName:    auth.Login,
+	proto.Cart_UpdateCart_FullMethodName: auth.Login,
+	proto.Cart_RemoveCart_FullMethodName: auth.Login,
+	proto.Cart_CartList_FullMethodName:   auth.Login,
+	proto.Cart_Checkout_FullMethodName:   auth.Login,
+
+	proto.Address_CreateAddress_FullMethodName:     auth.Login,
+	proto.Address_UpdateAddress_FullMethodName:     auth.Login,
+	proto.Address_DeleteAddress_FullMethodName:     auth.Login,
+	proto.Address_SetDefaultAddress_FullMethodName: auth.Login,
+	proto.Address_AddressList_FullMethodName:       auth.Login,
+
+	// 商家发货
+	proto.Order_CreateShipment_FullMethodName: auth.RequireRoles(auth.RoleMerchant),
+
+	// 平台运营
+	proto.Order_CreateCouponTemplate_FullMethodName: auth.RequireRoles(auth.RoleAdmin),
+	proto.Order_IssueCoupon_FullMethodName:          auth.RequireRoles(auth.RoleAdmin),
+
+	// 支付回调等内部流程修改订单状态
+	proto.Order_UpdateOrderStatus_FullMethodName: auth.Internal,
+}
@@ -0,0 +1,21 @@
+package model
+
+// Address 用户的收货地址
+type Address struct {
+	BaseModel
+
+	AddressId  int64
+	UserId     int64
+	Name       string
+	Phone      string
+	RegionCode string // 区县的6位行政区划代码
+	Province   string
+	City       string
+	District   string
+	Detail     string
+	IsDefault  bool
+}
+
+func (Address) TableName() string {
+	return "xx_address"
+}
@@ -12,7 +12,21 @@ type BaseModel struct {
 	IsDel    int8 `gorm:"index"`
 }
 
-// OrderGoodsStockInfo 订单商品信息
+// OrderPaidInfo 订单支付成功的消息，商品服务据此累计销量
+type OrderPaidInfo struct {
+	OrderId int64
+	UserId  int64
+	Lines   []*OrderPaidLine
+}
+
+type OrderPaidLine struct {
+	GoodsId int64
+	SkuId   int64
+	RoomId  int64
+	Num     int64
+}
+
+// OrderGoodsStockInfo 订单商品信息，GoodsId为0时表示整个订单
 type OrderGoodsStockInfo struct {
 	OrderId int64
 	GoodsId int64
@@ -0,0 +1,17 @@
+package model
+
+// Cart 购物车商品
+type Cart struct {
+	BaseModel
+
+	UserId   int64 `json:"userId"`
+	GoodsId  int64 `json:"goodsId"`
+	SkuId    int64 `json:"skuId"`
+	RoomId   int64 `json:"roomId"`
+	Num      int64 `json:"num"`
+	Selected bool  `json:"selected"`
+}
+
+func (Cart) TableName() string {
+	return "xx_cart"
+}
@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// 优惠券类型
+const (
+	CouponTypeFixed     = 1 // 立减
+	CouponTypePercent   = 2 // 折扣
+	CouponTypeThreshold = 3 // 满减
+)
+
+// 用户优惠券状态
+const (
+	CouponStatusUnused = 0 // 未使用
+	CouponStatusLocked = 1 // 下单锁定
+	CouponStatusUsed   = 2 // 已使用
+)
+
+// CouponTemplate 优惠券模板
+type CouponTemplate struct {
+	BaseModel
+
+	TemplateId   int64
+	Name         string
+	Type         int32
+	Amount       int64 // 立减/满减金额（分）
+	Rate         int32 // 折扣率，85表示按85%支付
+	Threshold    int64 // 使用门槛（分）
+	MaxDiscount  int64 // 折扣券最高优惠（分）
+	Total        int64
+	Issued       int64
+	PerUserLimit int32
+	StartTime    time.Time
+	EndTime      time.Time
+}
+
+func (CouponTemplate) TableName() string {
+	return "xx_coupon_template"
+}
+
+// UserCoupon 用户领取的优惠券
+type UserCoupon struct {
+	BaseModel
+
+	CouponId   int64
+	TemplateId int64
+	UserId     int64
+	Status     int32
+	OrderId    int64
+}
+
+func (UserCoupon) TableName() string {
+	return "xx_user_coupon"
+}
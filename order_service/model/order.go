@@ -1,5 +1,16 @@
 package model
 
+import "time"
+
+// 订单状态
+const (
+	OrderStatusUnpaid   = 100 // 创建订单/待支付
+	OrderStatusPaid     = 200 // 已支付
+	OrderStatusShipped  = 250 // 已发货，所有商品行都已发货
+	OrderStatusClosed   = 300 // 交易关闭
+	OrderStatusFinished = 400 // 完成
+)
+
 type Order struct {
 	BaseModel
 
@@ -8,9 +19,20 @@ type Order struct {
 	PayAmount int64
 	Status    int32
 
-	ReceiveAddress string
-	ReceiveName    string
-	ReceivePhone   string
+	OriginalAmount int64  // 优惠前金额（分）
+	DiscountAmount int64  // 优惠金额（分）
+	DiscountDetail string // 优惠明细json
+	CouponId       int64
+	ShippingFee    int64 // 运费（分）
+	PayTime        *time.Time
+	DeliveredAt    *time.Time // 所有包裹签收的时间，超过确认收货期限后自动完成
+
+	// 收货信息为下单时收货地址的快照，之后修改或删除地址不影响订单
+	AddressId         int64
+	ReceiveAddress    string
+	ReceiveName       string
+	ReceivePhone      string
+	ReceiveRegionCode string
 }
 
 func (Order) TableName() string {
@@ -1,12 +1,29 @@
 package model
 
+import "time"
+
+// OrderDetail 订单商品行，商品信息是下单时的快照，之后商品修改不影响已下的订单
 type OrderDetail struct {
 	BaseModel
 
 	OrderId int64
 	GoodsId int64
+	SkuId   int64
+	RoomId  int64 // 下单所在直播间，用于按直播间统计销量
 	UserId  int64
 	Num     int64
+
+	Title       string
+	SpecText    string
+	MarketPrice int64 // 市场价（分）
+	Price       int64 // 成交单价（分）
+	Brief       string
+	HeadImgs    string // json数组
+	Videos      string // json数组
+	Detail      string // json数组
+	PayAmount   int64  // 分摊订单优惠后的行支付金额（分），不含运费
+	PayTime     *time.Time
+	ShipmentId  int64 // 所在的包裹，0为未发货
 }
 
 func (OrderDetail) TableName() string {
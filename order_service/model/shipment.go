@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// 包裹的物流状态
+const (
+	ShipmentInTransit = 1 // 运输中
+	ShipmentDelivered = 2 // 已签收
+	ShipmentException = 3 // 异常，如拒收、丢件
+)
+
+// Shipment 订单的包裹，一个订单可以分多个包裹发货
+type Shipment struct {
+	BaseModel
+
+	ShipmentId  int64
+	OrderId     int64
+	Carrier     string // 承运商编码
+	TrackingNo  string
+	Status      int32
+	ShippedAt   time.Time
+	DeliveredAt *time.Time
+}
+
+func (Shipment) TableName() string {
+	return "xx_shipment"
+}
+
+// ShipmentEvent 承运商推送的物流轨迹
+type ShipmentEvent struct {
+	BaseModel
+
+	ShipmentId  int64
+	Status      int32
+	Description string
+	Location    string
+	EventTime   time.Time
+}
+
+func (ShipmentEvent) TableName() string {
+	return "xx_shipment_event"
+}
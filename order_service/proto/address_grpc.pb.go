@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.0
+// source: address.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Address_CreateAddress_FullMethodName     = "/proto.Address/CreateAddress"
+	Address_UpdateAddress_FullMethodName     = "/proto.Address/UpdateAddress"
+	Address_DeleteAddress_FullMethodName     = "/proto.Address/DeleteAddress"
+	Address_SetDefaultAddress_FullMethodName = "/proto.Address/SetDefaultAddress"
+	Address_AddressList_FullMethodName       = "/proto.Address/AddressList"
+)
+
+// AddressClient is the client API for Address service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AddressClient interface {
+	// 新增收货地址，第一个地址自动设为默认
+	CreateAddress(ctx context.Context, in *AddressInfo, opts ...grpc.CallOption) (*AddressInfo, error)
+	// 修改收货地址
+	UpdateAddress(ctx context.Context, in *AddressInfo, opts ...grpc.CallOption) (*AddressInfo, error)
+	// 删除收货地址，删除默认地址时最近添加的地址成为默认
+	DeleteAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*AddressListResp, error)
+	// 设为默认地址
+	SetDefaultAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*AddressListResp, error)
+	// 收货地址列表，默认地址在最前
+	AddressList(ctx context.Context, in *AddressListReq, opts ...grpc.CallOption) (*AddressListResp, error)
+}
+
+type addressClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAddressClient(cc grpc.ClientConnInterface) AddressClient {
+	return &addressClient{cc}
+}
+
+func (c *addressClient) CreateAddress(ctx context.Context, in *AddressInfo, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, Address_CreateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressClient) UpdateAddress(ctx context.Context, in *AddressInfo, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, Address_UpdateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressClient) DeleteAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*AddressListResp, error) {
+	out := new(AddressListResp)
+	err := c.cc.Invoke(ctx, Address_DeleteAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressClient) SetDefaultAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*AddressListResp, error) {
+	out := new(AddressListResp)
+	err := c.cc.Invoke(ctx, Address_SetDefaultAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressClient) AddressList(ctx context.Context, in *AddressListReq, opts ...grpc.CallOption) (*AddressListResp, error) {
+	out := new(AddressListResp)
+	err := c.cc.Invoke(ctx, Address_AddressList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddressServer is the server API for Address service.
+// All implementations must embed UnimplementedAddressServer
+// for forward compatibility
+type AddressServer interface {
+	// 新增收货地址，第一个地址自动设为默认
+	CreateAddress(context.Context, *AddressInfo) (*AddressInfo, error)
+	// 修改收货地址
+	UpdateAddress(context.Context, *AddressInfo) (*AddressInfo, error)
+	// 删除收货地址，删除默认地址时最近添加的地址成为默认
+	DeleteAddress(context.Context, *AddressIdReq) (*AddressListResp, error)
+	// 设为默认地址
+	SetDefaultAddress(context.Context, *AddressIdReq) (*AddressListResp, error)
+	// 收货地址列表，默认地址在最前
+	AddressList(context.Context, *AddressListReq) (*AddressListResp, error)
+	mustEmbedUnimplementedAddressServer()
+}
+
+// UnimplementedAddressServer must be embedded to have forward compatible implementations.
+type UnimplementedAddressServer struct {
+}
+
+func (UnimplementedAddressServer) CreateAddress(context.Context, *AddressInfo) (*AddressInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddress not implemented")
+}
+func (UnimplementedAddressServer) UpdateAddress(context.Context, *AddressInfo) (*AddressInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedAddressServer) DeleteAddress(context.Context, *AddressIdReq) (*AddressListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+}
+func (UnimplementedAddressServer) SetDefaultAddress(context.Context, *AddressIdReq) (*AddressListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddress not implemented")
+}
+func (UnimplementedAddressServer) AddressList(context.Context, *AddressListReq) (*AddressListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressList not implemented")
+}
+func (UnimplementedAddressServer) mustEmbedUnimplementedAddressServer() {}
+
+// UnsafeAddressServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AddressServer will
+// result in compilation errors.
+type UnsafeAddressServer interface {
+	mustEmbedUnimplementedAddressServer()
+}
+
+func RegisterAddressServer(s grpc.ServiceRegistrar, srv AddressServer) {
+	s.RegisterService(&Address_ServiceDesc, srv)
+}
+
+func _Address_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServer).CreateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Address_CreateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServer).CreateAddress(ctx, req.(*AddressInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Address_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Address_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServer).UpdateAddress(ctx, req.(*AddressInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Address_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Address_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServer).DeleteAddress(ctx, req.(*AddressIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Address_SetDefaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServer).SetDefaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Address_SetDefaultAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServer).SetDefaultAddress(ctx, req.(*AddressIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Address_AddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServer).AddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Address_AddressList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServer).AddressList(ctx, req.(*AddressListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Address_ServiceDesc is the grpc.ServiceDesc for Address service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Address_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Address",
+	HandlerType: (*AddressServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAddress",
+			Handler:    _Address_CreateAddress_Handler,
+		},
+		{
+			MethodName: "UpdateAddress",
+			Handler:    _Address_UpdateAddress_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _Address_DeleteAddress_Handler,
+		},
+		{
+			MethodName: "SetDefaultAddress",
+			Handler:    _Address_SetDefaultAddress_Handler,
+		},
+		{
+			MethodName: "AddressList",
+			Handler:    _Address_AddressList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "address.proto",
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.0
+// source: cart.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Cart_AddCart_FullMethodName    = "/proto.Cart/AddCart"
+	Cart_UpdateCart_FullMethodName = "/proto.Cart/UpdateCart"
+	Cart_RemoveCart_FullMethodName = "/proto.Cart/RemoveCart"
+	Cart_CartList_FullMethodName   = "/proto.Cart/CartList"
+	Cart_Checkout_FullMethodName   = "/proto.Cart/Checkout"
+)
+
+// CartClient is the client API for Cart service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CartClient interface {
+	// 加入购物车
+	AddCart(ctx context.Context, in *CartItemReq, opts ...grpc.CallOption) (*CartListResp, error)
+	// 修改购物车商品数量/选中状态
+	UpdateCart(ctx context.Context, in *CartItemReq, opts ...grpc.CallOption) (*CartListResp, error)
+	// 删除购物车商品
+	RemoveCart(ctx context.Context, in *RemoveCartReq, opts ...grpc.CallOption) (*CartListResp, error)
+	// 购物车列表
+	CartList(ctx context.Context, in *CartListReq, opts ...grpc.CallOption) (*CartListResp, error)
+	// 结算选中的购物车商品并创建订单
+	Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*OrderBaseResp, error)
+}
+
+type cartClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCartClient(cc grpc.ClientConnInterface) CartClient {
+	return &cartClient{cc}
+}
+
+func (c *cartClient) AddCart(ctx context.Context, in *CartItemReq, opts ...grpc.CallOption) (*CartListResp, error) {
+	out := new(CartListResp)
+	err := c.cc.Invoke(ctx, Cart_AddCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateCart(ctx context.Context, in *CartItemReq, opts ...grpc.CallOption) (*CartListResp, error) {
+	out := new(CartListResp)
+	err := c.cc.Invoke(ctx, Cart_UpdateCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveCart(ctx context.Context, in *RemoveCartReq, opts ...grpc.CallOption) (*CartListResp, error) {
+	out := new(CartListResp)
+	err := c.cc.Invoke(ctx, Cart_RemoveCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) CartList(ctx context.Context, in *CartListReq, opts ...grpc.CallOption) (*CartListResp, error) {
+	out := new(CartListResp)
+	err := c.cc.Invoke(ctx, Cart_CartList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) Checkout(ctx context.Context, in *CheckoutReq, opts ...grpc.CallOption) (*OrderBaseResp, error) {
+	out := new(OrderBaseResp)
+	err := c.cc.Invoke(ctx, Cart_Checkout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartServer is the server API for Cart service.
+// All implementations must embed UnimplementedCartServer
+// for forward compatibility
+type CartServer interface {
+	// 加入购物车
+	AddCart(context.Context, *CartItemReq) (*CartListResp, error)
+	// 修改购物车商品数量/选中状态
+	UpdateCart(context.Context, *CartItemReq) (*CartListResp, error)
+	// 删除购物车商品
+	RemoveCart(context.Context, *RemoveCartReq) (*CartListResp, error)
+	// 购物车列表
+	CartList(context.Context, *CartListReq) (*CartListResp, error)
+	// 结算选中的购物车商品并创建订单
+	Checkout(context.Context, *CheckoutReq) (*OrderBaseResp, error)
+	mustEmbedUnimplementedCartServer()
+}
+
+// UnimplementedCartServer must be embedded to have forward compatible implementations.
+type UnimplementedCartServer struct {
+}
+
+func (UnimplementedCartServer) AddCart(context.Context, *CartItemReq) (*CartListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCart not implemented")
+}
+func (UnimplementedCartServer) UpdateCart(context.Context, *CartItemReq) (*CartListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCart not implemented")
+}
+func (UnimplementedCartServer) RemoveCart(context.Context, *RemoveCartReq) (*CartListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCart not implemented")
+}
+func (UnimplementedCartServer) CartList(context.Context, *CartListReq) (*CartListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartList not implemented")
+}
+func (UnimplementedCartServer) Checkout(context.Context, *CheckoutReq) (*OrderBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedCartServer) mustEmbedUnimplementedCartServer() {}
+
+// UnsafeCartServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CartServer will
+// result in compilation errors.
+type UnsafeCartServer interface {
+	mustEmbedUnimplementedCartServer()
+}
+
+func RegisterCartServer(s grpc.ServiceRegistrar, srv CartServer) {
+	s.RegisterService(&Cart_ServiceDesc, srv)
+}
+
+func _Cart_AddCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddCart(ctx, req.(*CartItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateCart(ctx, req.(*CartItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveCart(ctx, req.(*RemoveCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_CartList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CartList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CartList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CartList(ctx, req.(*CartListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_Checkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).Checkout(ctx, req.(*CheckoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Cart_ServiceDesc is the grpc.ServiceDesc for Cart service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cart_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Cart",
+	HandlerType: (*CartServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCart",
+			Handler:    _Cart_AddCart_Handler,
+		},
+		{
+			MethodName: "UpdateCart",
+			Handler:    _Cart_UpdateCart_Handler,
+		},
+		{
+			MethodName: "RemoveCart",
+			Handler:    _Cart_RemoveCart_Handler,
+		},
+		{
+			MethodName: "CartList",
+			Handler:    _Cart_CartList_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _Cart_Checkout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cart.proto",
+}
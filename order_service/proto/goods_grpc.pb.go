@@ -19,8 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Goods_GetGoodsByRoom_FullMethodName = "/proto.Goods/GetGoodsByRoom"
-	Goods_GetGoodsDetail_FullMethodName = "/proto.Goods/GetGoodsDetail"
+	Goods_GetGoodsByRoom_FullMethodName      = "/proto.Goods/GetGoodsByRoom"
+	Goods_GetRoomSalesRank_FullMethodName    = "/proto.Goods/GetRoomSalesRank"
+	Goods_GetGoodsDetail_FullMethodName      = "/proto.Goods/GetGoodsDetail"
+	Goods_BatchGetGoodsDetail_FullMethodName = "/proto.Goods/BatchGetGoodsDetail"
+	Goods_CreateFlashSale_FullMethodName     = "/proto.Goods/CreateFlashSale"
+	Goods_CreateGoods_FullMethodName         = "/proto.Goods/CreateGoods"
+	Goods_UpdateGoods_FullMethodName         = "/proto.Goods/UpdateGoods"
+	Goods_DeleteGoods_FullMethodName         = "/proto.Goods/DeleteGoods"
+	Goods_ListGoods_FullMethodName           = "/proto.Goods/ListGoods"
+	Goods_SearchGoods_FullMethodName         = "/proto.Goods/SearchGoods"
+	Goods_AddRoomGoods_FullMethodName        = "/proto.Goods/AddRoomGoods"
+	Goods_RemoveRoomGoods_FullMethodName     = "/proto.Goods/RemoveRoomGoods"
+	Goods_SortRoomGoods_FullMethodName       = "/proto.Goods/SortRoomGoods"
+	Goods_SetCurrentGoods_FullMethodName     = "/proto.Goods/SetCurrentGoods"
+	Goods_SetGoodsSku_FullMethodName         = "/proto.Goods/SetGoodsSku"
+	Goods_SchedulePrice_FullMethodName       = "/proto.Goods/SchedulePrice"
+	Goods_GetPriceHistory_FullMethodName     = "/proto.Goods/GetPriceHistory"
+	Goods_CreateCategory_FullMethodName      = "/proto.Goods/CreateCategory"
+	Goods_UpdateCategory_FullMethodName      = "/proto.Goods/UpdateCategory"
+	Goods_CategoryTree_FullMethodName        = "/proto.Goods/CategoryTree"
+	Goods_CreateBrand_FullMethodName         = "/proto.Goods/CreateBrand"
+	Goods_UpdateBrand_FullMethodName         = "/proto.Goods/UpdateBrand"
+	Goods_ListBrand_FullMethodName           = "/proto.Goods/ListBrand"
+	Goods_SetCategoryAttrs_FullMethodName    = "/proto.Goods/SetCategoryAttrs"
+	Goods_GetCategoryAttrs_FullMethodName    = "/proto.Goods/GetCategoryAttrs"
+	Goods_UploadMedia_FullMethodName         = "/proto.Goods/UploadMedia"
+	Goods_CreateReview_FullMethodName        = "/proto.Goods/CreateReview"
+	Goods_ModerateReview_FullMethodName      = "/proto.Goods/ModerateReview"
+	Goods_ListReviews_FullMethodName         = "/proto.Goods/ListReviews"
+	Goods_WatchRoom_FullMethodName           = "/proto.Goods/WatchRoom"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -28,7 +56,35 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GoodsClient interface {
 	GetGoodsByRoom(ctx context.Context, in *GetGoodsByRoomReq, opts ...grpc.CallOption) (*GoodsListResp, error)
+	GetRoomSalesRank(ctx context.Context, in *RoomSalesRankReq, opts ...grpc.CallOption) (*GoodsListResp, error)
 	GetGoodsDetail(ctx context.Context, in *GetGoodsDetailReq, opts ...grpc.CallOption) (*GoodsDetail, error)
+	BatchGetGoodsDetail(ctx context.Context, in *BatchGetGoodsDetailReq, opts ...grpc.CallOption) (*BatchGetGoodsDetailResp, error)
+	CreateFlashSale(ctx context.Context, in *CreateFlashSaleReq, opts ...grpc.CallOption) (*FlashSaleInfo, error)
+	CreateGoods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsDetail, error)
+	UpdateGoods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsDetail, error)
+	DeleteGoods(ctx context.Context, in *DeleteGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error)
+	ListGoods(ctx context.Context, in *ListGoodsReq, opts ...grpc.CallOption) (*ListGoodsResp, error)
+	SearchGoods(ctx context.Context, in *SearchGoodsReq, opts ...grpc.CallOption) (*ListGoodsResp, error)
+	AddRoomGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error)
+	RemoveRoomGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error)
+	SortRoomGoods(ctx context.Context, in *SortRoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error)
+	SetCurrentGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error)
+	SetGoodsSku(ctx context.Context, in *SetGoodsSkuReq, opts ...grpc.CallOption) (*GoodsDetail, error)
+	SchedulePrice(ctx context.Context, in *SchedulePriceReq, opts ...grpc.CallOption) (*PriceChangeInfo, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryReq, opts ...grpc.CallOption) (*PriceHistoryResp, error)
+	CreateCategory(ctx context.Context, in *CategoryReq, opts ...grpc.CallOption) (*CategoryInfo, error)
+	UpdateCategory(ctx context.Context, in *CategoryReq, opts ...grpc.CallOption) (*CategoryInfo, error)
+	CategoryTree(ctx context.Context, in *CategoryTreeReq, opts ...grpc.CallOption) (*CategoryTreeResp, error)
+	CreateBrand(ctx context.Context, in *BrandReq, opts ...grpc.CallOption) (*BrandInfo, error)
+	UpdateBrand(ctx context.Context, in *BrandReq, opts ...grpc.CallOption) (*BrandInfo, error)
+	ListBrand(ctx context.Context, in *ListBrandReq, opts ...grpc.CallOption) (*ListBrandResp, error)
+	SetCategoryAttrs(ctx context.Context, in *SetCategoryAttrsReq, opts ...grpc.CallOption) (*CategoryAttrsResp, error)
+	GetCategoryAttrs(ctx context.Context, in *GetCategoryAttrsReq, opts ...grpc.CallOption) (*CategoryAttrsResp, error)
+	UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Goods_UploadMediaClient, error)
+	CreateReview(ctx context.Context, in *CreateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error)
+	ListReviews(ctx context.Context, in *ListReviewsReq, opts ...grpc.CallOption) (*ListReviewsResp, error)
+	WatchRoom(ctx context.Context, in *WatchRoomReq, opts ...grpc.CallOption) (Goods_WatchRoomClient, error)
 }
 
 type goodsClient struct {
@@ -48,6 +104,15 @@ func (c *goodsClient) GetGoodsByRoom(ctx context.Context, in *GetGoodsByRoomReq,
 	return out, nil
 }
 
+func (c *goodsClient) GetRoomSalesRank(ctx context.Context, in *RoomSalesRankReq, opts ...grpc.CallOption) (*GoodsListResp, error) {
+	out := new(GoodsListResp)
+	err := c.cc.Invoke(ctx, Goods_GetRoomSalesRank_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GetGoodsDetailReq, opts ...grpc.CallOption) (*GoodsDetail, error) {
 	out := new(GoodsDetail)
 	err := c.cc.Invoke(ctx, Goods_GetGoodsDetail_FullMethodName, in, out, opts...)
@@ -57,12 +122,331 @@ func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GetGoodsDetailReq,
 	return out, nil
 }
 
+func (c *goodsClient) BatchGetGoodsDetail(ctx context.Context, in *BatchGetGoodsDetailReq, opts ...grpc.CallOption) (*BatchGetGoodsDetailResp, error) {
+	out := new(BatchGetGoodsDetailResp)
+	err := c.cc.Invoke(ctx, Goods_BatchGetGoodsDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleReq, opts ...grpc.CallOption) (*FlashSaleInfo, error) {
+	out := new(FlashSaleInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateFlashSale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateGoods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsDetail, error) {
+	out := new(GoodsDetail)
+	err := c.cc.Invoke(ctx, Goods_CreateGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateGoods(ctx context.Context, in *GoodsReq, opts ...grpc.CallOption) (*GoodsDetail, error) {
+	out := new(GoodsDetail)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteGoods(ctx context.Context, in *DeleteGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error) {
+	out := new(GoodsBaseResp)
+	err := c.cc.Invoke(ctx, Goods_DeleteGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ListGoods(ctx context.Context, in *ListGoodsReq, opts ...grpc.CallOption) (*ListGoodsResp, error) {
+	out := new(ListGoodsResp)
+	err := c.cc.Invoke(ctx, Goods_ListGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SearchGoods(ctx context.Context, in *SearchGoodsReq, opts ...grpc.CallOption) (*ListGoodsResp, error) {
+	out := new(ListGoodsResp)
+	err := c.cc.Invoke(ctx, Goods_SearchGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) AddRoomGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error) {
+	out := new(GoodsBaseResp)
+	err := c.cc.Invoke(ctx, Goods_AddRoomGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) RemoveRoomGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error) {
+	out := new(GoodsBaseResp)
+	err := c.cc.Invoke(ctx, Goods_RemoveRoomGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SortRoomGoods(ctx context.Context, in *SortRoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error) {
+	out := new(GoodsBaseResp)
+	err := c.cc.Invoke(ctx, Goods_SortRoomGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SetCurrentGoods(ctx context.Context, in *RoomGoodsReq, opts ...grpc.CallOption) (*GoodsBaseResp, error) {
+	out := new(GoodsBaseResp)
+	err := c.cc.Invoke(ctx, Goods_SetCurrentGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SetGoodsSku(ctx context.Context, in *SetGoodsSkuReq, opts ...grpc.CallOption) (*GoodsDetail, error) {
+	out := new(GoodsDetail)
+	err := c.cc.Invoke(ctx, Goods_SetGoodsSku_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SchedulePrice(ctx context.Context, in *SchedulePriceReq, opts ...grpc.CallOption) (*PriceChangeInfo, error) {
+	out := new(PriceChangeInfo)
+	err := c.cc.Invoke(ctx, Goods_SchedulePrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryReq, opts ...grpc.CallOption) (*PriceHistoryResp, error) {
+	out := new(PriceHistoryResp)
+	err := c.cc.Invoke(ctx, Goods_GetPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateCategory(ctx context.Context, in *CategoryReq, opts ...grpc.CallOption) (*CategoryInfo, error) {
+	out := new(CategoryInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryReq, opts ...grpc.CallOption) (*CategoryInfo, error) {
+	out := new(CategoryInfo)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CategoryTree(ctx context.Context, in *CategoryTreeReq, opts ...grpc.CallOption) (*CategoryTreeResp, error) {
+	out := new(CategoryTreeResp)
+	err := c.cc.Invoke(ctx, Goods_CategoryTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateBrand(ctx context.Context, in *BrandReq, opts ...grpc.CallOption) (*BrandInfo, error) {
+	out := new(BrandInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateBrand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateBrand(ctx context.Context, in *BrandReq, opts ...grpc.CallOption) (*BrandInfo, error) {
+	out := new(BrandInfo)
+	err := c.cc.Invoke(ctx, Goods_UpdateBrand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ListBrand(ctx context.Context, in *ListBrandReq, opts ...grpc.CallOption) (*ListBrandResp, error) {
+	out := new(ListBrandResp)
+	err := c.cc.Invoke(ctx, Goods_ListBrand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SetCategoryAttrs(ctx context.Context, in *SetCategoryAttrsReq, opts ...grpc.CallOption) (*CategoryAttrsResp, error) {
+	out := new(CategoryAttrsResp)
+	err := c.cc.Invoke(ctx, Goods_SetCategoryAttrs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetCategoryAttrs(ctx context.Context, in *GetCategoryAttrsReq, opts ...grpc.CallOption) (*CategoryAttrsResp, error) {
+	out := new(CategoryAttrsResp)
+	err := c.cc.Invoke(ctx, Goods_GetCategoryAttrs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UploadMedia(ctx context.Context, opts ...grpc.CallOption) (Goods_UploadMediaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Goods_ServiceDesc.Streams[0], Goods_UploadMedia_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goodsUploadMediaClient{stream}
+	return x, nil
+}
+
+type Goods_UploadMediaClient interface {
+	Send(*UploadMediaReq) error
+	CloseAndRecv() (*MediaInfo, error)
+	grpc.ClientStream
+}
+
+type goodsUploadMediaClient struct {
+	grpc.ClientStream
+}
+
+func (x *goodsUploadMediaClient) Send(m *UploadMediaReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *goodsUploadMediaClient) CloseAndRecv() (*MediaInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(MediaInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goodsClient) CreateReview(ctx context.Context, in *CreateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error) {
+	out := new(ReviewInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ModerateReview(ctx context.Context, in *ModerateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error) {
+	out := new(ReviewInfo)
+	err := c.cc.Invoke(ctx, Goods_ModerateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ListReviews(ctx context.Context, in *ListReviewsReq, opts ...grpc.CallOption) (*ListReviewsResp, error) {
+	out := new(ListReviewsResp)
+	err := c.cc.Invoke(ctx, Goods_ListReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) WatchRoom(ctx context.Context, in *WatchRoomReq, opts ...grpc.CallOption) (Goods_WatchRoomClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Goods_ServiceDesc.Streams[1], Goods_WatchRoom_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goodsWatchRoomClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Goods_WatchRoomClient interface {
+	Recv() (*RoomEvent, error)
+	grpc.ClientStream
+}
+
+type goodsWatchRoomClient struct {
+	grpc.ClientStream
+}
+
+func (x *goodsWatchRoomClient) Recv() (*RoomEvent, error) {
+	m := new(RoomEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GoodsServer is the server API for Goods service.
 // All implementations must embed UnimplementedGoodsServer
 // for forward compatibility
 type GoodsServer interface {
 	GetGoodsByRoom(context.Context, *GetGoodsByRoomReq) (*GoodsListResp, error)
+	GetRoomSalesRank(context.Context, *RoomSalesRankReq) (*GoodsListResp, error)
 	GetGoodsDetail(context.Context, *GetGoodsDetailReq) (*GoodsDetail, error)
+	BatchGetGoodsDetail(context.Context, *BatchGetGoodsDetailReq) (*BatchGetGoodsDetailResp, error)
+	CreateFlashSale(context.Context, *CreateFlashSaleReq) (*FlashSaleInfo, error)
+	CreateGoods(context.Context, *GoodsReq) (*GoodsDetail, error)
+	UpdateGoods(context.Context, *GoodsReq) (*GoodsDetail, error)
+	DeleteGoods(context.Context, *DeleteGoodsReq) (*GoodsBaseResp, error)
+	ListGoods(context.Context, *ListGoodsReq) (*ListGoodsResp, error)
+	SearchGoods(context.Context, *SearchGoodsReq) (*ListGoodsResp, error)
+	AddRoomGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error)
+	RemoveRoomGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error)
+	SortRoomGoods(context.Context, *SortRoomGoodsReq) (*GoodsBaseResp, error)
+	SetCurrentGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error)
+	SetGoodsSku(context.Context, *SetGoodsSkuReq) (*GoodsDetail, error)
+	SchedulePrice(context.Context, *SchedulePriceReq) (*PriceChangeInfo, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryReq) (*PriceHistoryResp, error)
+	CreateCategory(context.Context, *CategoryReq) (*CategoryInfo, error)
+	UpdateCategory(context.Context, *CategoryReq) (*CategoryInfo, error)
+	CategoryTree(context.Context, *CategoryTreeReq) (*CategoryTreeResp, error)
+	CreateBrand(context.Context, *BrandReq) (*BrandInfo, error)
+	UpdateBrand(context.Context, *BrandReq) (*BrandInfo, error)
+	ListBrand(context.Context, *ListBrandReq) (*ListBrandResp, error)
+	SetCategoryAttrs(context.Context, *SetCategoryAttrsReq) (*CategoryAttrsResp, error)
+	GetCategoryAttrs(context.Context, *GetCategoryAttrsReq) (*CategoryAttrsResp, error)
+	UploadMedia(Goods_UploadMediaServer) error
+	CreateReview(context.Context, *CreateReviewReq) (*ReviewInfo, error)
+	ModerateReview(context.Context, *ModerateReviewReq) (*ReviewInfo, error)
+	ListReviews(context.Context, *ListReviewsReq) (*ListReviewsResp, error)
+	WatchRoom(*WatchRoomReq, Goods_WatchRoomServer) error
 	mustEmbedUnimplementedGoodsServer()
 }
 
@@ -73,9 +457,93 @@ type UnimplementedGoodsServer struct {
 func (UnimplementedGoodsServer) GetGoodsByRoom(context.Context, *GetGoodsByRoomReq) (*GoodsListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsByRoom not implemented")
 }
+func (UnimplementedGoodsServer) GetRoomSalesRank(context.Context, *RoomSalesRankReq) (*GoodsListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomSalesRank not implemented")
+}
 func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GetGoodsDetailReq) (*GoodsDetail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
 }
+func (UnimplementedGoodsServer) BatchGetGoodsDetail(context.Context, *BatchGetGoodsDetailReq) (*BatchGetGoodsDetailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGoodsDetail not implemented")
+}
+func (UnimplementedGoodsServer) CreateFlashSale(context.Context, *CreateFlashSaleReq) (*FlashSaleInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlashSale not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoods(context.Context, *GoodsReq) (*GoodsDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoods not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoods(context.Context, *GoodsReq) (*GoodsDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoods not implemented")
+}
+func (UnimplementedGoodsServer) DeleteGoods(context.Context, *DeleteGoodsReq) (*GoodsBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoods not implemented")
+}
+func (UnimplementedGoodsServer) ListGoods(context.Context, *ListGoodsReq) (*ListGoodsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGoods not implemented")
+}
+func (UnimplementedGoodsServer) SearchGoods(context.Context, *SearchGoodsReq) (*ListGoodsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGoods not implemented")
+}
+func (UnimplementedGoodsServer) AddRoomGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoomGoods not implemented")
+}
+func (UnimplementedGoodsServer) RemoveRoomGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoomGoods not implemented")
+}
+func (UnimplementedGoodsServer) SortRoomGoods(context.Context, *SortRoomGoodsReq) (*GoodsBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortRoomGoods not implemented")
+}
+func (UnimplementedGoodsServer) SetCurrentGoods(context.Context, *RoomGoodsReq) (*GoodsBaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrentGoods not implemented")
+}
+func (UnimplementedGoodsServer) SetGoodsSku(context.Context, *SetGoodsSkuReq) (*GoodsDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGoodsSku not implemented")
+}
+func (UnimplementedGoodsServer) SchedulePrice(context.Context, *SchedulePriceReq) (*PriceChangeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePrice not implemented")
+}
+func (UnimplementedGoodsServer) GetPriceHistory(context.Context, *GetPriceHistoryReq) (*PriceHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategory(context.Context, *CategoryReq) (*CategoryInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryReq) (*CategoryInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedGoodsServer) CategoryTree(context.Context, *CategoryTreeReq) (*CategoryTreeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryTree not implemented")
+}
+func (UnimplementedGoodsServer) CreateBrand(context.Context, *BrandReq) (*BrandInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+}
+func (UnimplementedGoodsServer) UpdateBrand(context.Context, *BrandReq) (*BrandInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+}
+func (UnimplementedGoodsServer) ListBrand(context.Context, *ListBrandReq) (*ListBrandResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrand not implemented")
+}
+func (UnimplementedGoodsServer) SetCategoryAttrs(context.Context, *SetCategoryAttrsReq) (*CategoryAttrsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryAttrs not implemented")
+}
+func (UnimplementedGoodsServer) GetCategoryAttrs(context.Context, *GetCategoryAttrsReq) (*CategoryAttrsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryAttrs not implemented")
+}
+func (UnimplementedGoodsServer) UploadMedia(Goods_UploadMediaServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedGoodsServer) CreateReview(context.Context, *CreateReviewReq) (*ReviewInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedGoodsServer) ModerateReview(context.Context, *ModerateReviewReq) (*ReviewInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedGoodsServer) ListReviews(context.Context, *ListReviewsReq) (*ListReviewsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedGoodsServer) WatchRoom(*WatchRoomReq, Goods_WatchRoomServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRoom not implemented")
+}
 func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
 
 // UnsafeGoodsServer may be embedded to opt out of forward compatibility for this service.
@@ -107,6 +575,24 @@ func _Goods_GetGoodsByRoom_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_GetRoomSalesRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomSalesRankReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetRoomSalesRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetRoomSalesRank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetRoomSalesRank(ctx, req.(*RoomSalesRankReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGoodsDetailReq)
 	if err := dec(in); err != nil {
@@ -125,22 +611,634 @@ func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-// Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Goods_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.Goods",
-	HandlerType: (*GoodsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetGoodsByRoom",
-			Handler:    _Goods_GetGoodsByRoom_Handler,
-		},
-		{
-			MethodName: "GetGoodsDetail",
-			Handler:    _Goods_GetGoodsDetail_Handler,
+func _Goods_BatchGetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetGoodsDetailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetGoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetGoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetGoodsDetail(ctx, req.(*BatchGetGoodsDetailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlashSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateFlashSale(ctx, req.(*CreateFlashSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoods(ctx, req.(*GoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoods(ctx, req.(*GoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteGoods(ctx, req.(*DeleteGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ListGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ListGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ListGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ListGoods(ctx, req.(*ListGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SearchGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SearchGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SearchGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SearchGoods(ctx, req.(*SearchGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_AddRoomGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).AddRoomGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_AddRoomGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).AddRoomGoods(ctx, req.(*RoomGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_RemoveRoomGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).RemoveRoomGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_RemoveRoomGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).RemoveRoomGoods(ctx, req.(*RoomGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SortRoomGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortRoomGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SortRoomGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SortRoomGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SortRoomGoods(ctx, req.(*SortRoomGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SetCurrentGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SetCurrentGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SetCurrentGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SetCurrentGoods(ctx, req.(*RoomGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SetGoodsSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGoodsSkuReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SetGoodsSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SetGoodsSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SetGoodsSku(ctx, req.(*SetGoodsSkuReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SchedulePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SchedulePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SchedulePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SchedulePrice(ctx, req.(*SchedulePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetPriceHistory(ctx, req.(*GetPriceHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateCategory(ctx, req.(*CategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateCategory(ctx, req.(*CategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryTreeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CategoryTree(ctx, req.(*CategoryTreeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateBrand(ctx, req.(*BrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateBrand(ctx, req.(*BrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ListBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ListBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ListBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ListBrand(ctx, req.(*ListBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SetCategoryAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryAttrsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SetCategoryAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SetCategoryAttrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SetCategoryAttrs(ctx, req.(*SetCategoryAttrsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetCategoryAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryAttrsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetCategoryAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetCategoryAttrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetCategoryAttrs(ctx, req.(*GetCategoryAttrsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UploadMedia_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoodsServer).UploadMedia(&goodsUploadMediaServer{stream})
+}
+
+type Goods_UploadMediaServer interface {
+	SendAndClose(*MediaInfo) error
+	Recv() (*UploadMediaReq, error)
+	grpc.ServerStream
+}
+
+type goodsUploadMediaServer struct {
+	grpc.ServerStream
+}
+
+func (x *goodsUploadMediaServer) SendAndClose(m *MediaInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *goodsUploadMediaServer) Recv() (*UploadMediaReq, error) {
+	m := new(UploadMediaReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Goods_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateReview(ctx, req.(*CreateReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ModerateReview(ctx, req.(*ModerateReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ListReviews(ctx, req.(*ListReviewsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_WatchRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRoomReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoodsServer).WatchRoom(m, &goodsWatchRoomServer{stream})
+}
+
+type Goods_WatchRoomServer interface {
+	Send(*RoomEvent) error
+	grpc.ServerStream
+}
+
+type goodsWatchRoomServer struct {
+	grpc.ServerStream
+}
+
+func (x *goodsWatchRoomServer) Send(m *RoomEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Goods_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Goods",
+	HandlerType: (*GoodsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGoodsByRoom",
+			Handler:    _Goods_GetGoodsByRoom_Handler,
+		},
+		{
+			MethodName: "GetRoomSalesRank",
+			Handler:    _Goods_GetRoomSalesRank_Handler,
+		},
+		{
+			MethodName: "GetGoodsDetail",
+			Handler:    _Goods_GetGoodsDetail_Handler,
+		},
+		{
+			MethodName: "BatchGetGoodsDetail",
+			Handler:    _Goods_BatchGetGoodsDetail_Handler,
+		},
+		{
+			MethodName: "CreateFlashSale",
+			Handler:    _Goods_CreateFlashSale_Handler,
+		},
+		{
+			MethodName: "CreateGoods",
+			Handler:    _Goods_CreateGoods_Handler,
+		},
+		{
+			MethodName: "UpdateGoods",
+			Handler:    _Goods_UpdateGoods_Handler,
+		},
+		{
+			MethodName: "DeleteGoods",
+			Handler:    _Goods_DeleteGoods_Handler,
+		},
+		{
+			MethodName: "ListGoods",
+			Handler:    _Goods_ListGoods_Handler,
+		},
+		{
+			MethodName: "SearchGoods",
+			Handler:    _Goods_SearchGoods_Handler,
+		},
+		{
+			MethodName: "AddRoomGoods",
+			Handler:    _Goods_AddRoomGoods_Handler,
+		},
+		{
+			MethodName: "RemoveRoomGoods",
+			Handler:    _Goods_RemoveRoomGoods_Handler,
+		},
+		{
+			MethodName: "SortRoomGoods",
+			Handler:    _Goods_SortRoomGoods_Handler,
+		},
+		{
+			MethodName: "SetCurrentGoods",
+			Handler:    _Goods_SetCurrentGoods_Handler,
+		},
+		{
+			MethodName: "SetGoodsSku",
+			Handler:    _Goods_SetGoodsSku_Handler,
+		},
+		{
+			MethodName: "SchedulePrice",
+			Handler:    _Goods_SchedulePrice_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Goods_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _Goods_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Goods_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "CategoryTree",
+			Handler:    _Goods_CategoryTree_Handler,
+		},
+		{
+			MethodName: "CreateBrand",
+			Handler:    _Goods_CreateBrand_Handler,
+		},
+		{
+			MethodName: "UpdateBrand",
+			Handler:    _Goods_UpdateBrand_Handler,
+		},
+		{
+			MethodName: "ListBrand",
+			Handler:    _Goods_ListBrand_Handler,
+		},
+		{
+			MethodName: "SetCategoryAttrs",
+			Handler:    _Goods_SetCategoryAttrs_Handler,
+		},
+		{
+			MethodName: "GetCategoryAttrs",
+			Handler:    _Goods_GetCategoryAttrs_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Goods_CreateReview_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _Goods_ModerateReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _Goods_ListReviews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadMedia",
+			Handler:       _Goods_UploadMedia_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchRoom",
+			Handler:       _Goods_WatchRoom_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "goods.proto",
 }
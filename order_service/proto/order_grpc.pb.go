@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Order_CreateOrder_FullMethodName       = "/proto.Order/CreateOrder"
-	Order_OrderList_FullMethodName         = "/proto.Order/OrderList"
-	Order_OrderDetail_FullMethodName       = "/proto.Order/OrderDetail"
-	Order_UpdateOrderStatus_FullMethodName = "/proto.Order/UpdateOrderStatus"
+	Order_CreateOrder_FullMethodName          = "/proto.Order/CreateOrder"
+	Order_PreviewOrder_FullMethodName         = "/proto.Order/PreviewOrder"
+	Order_OrderList_FullMethodName            = "/proto.Order/OrderList"
+	Order_OrderDetail_FullMethodName          = "/proto.Order/OrderDetail"
+	Order_UpdateOrderStatus_FullMethodName    = "/proto.Order/UpdateOrderStatus"
+	Order_ReviewOrderGoods_FullMethodName     = "/proto.Order/ReviewOrderGoods"
+	Order_CreateShipment_FullMethodName       = "/proto.Order/CreateShipment"
+	Order_ListShipments_FullMethodName        = "/proto.Order/ListShipments"
+	Order_CreateCouponTemplate_FullMethodName = "/proto.Order/CreateCouponTemplate"
+	Order_IssueCoupon_FullMethodName          = "/proto.Order/IssueCoupon"
+	Order_UserCouponList_FullMethodName       = "/proto.Order/UserCouponList"
 )
 
 // OrderClient is the client API for Order service.
@@ -31,12 +38,26 @@ const (
 type OrderClient interface {
 	// 创建订单
 	CreateOrder(ctx context.Context, in *OrderReq, opts ...grpc.CallOption) (*OrderBaseResp, error)
+	// 下单前预览订单金额
+	PreviewOrder(ctx context.Context, in *OrderReq, opts ...grpc.CallOption) (*OrderQuote, error)
 	// 订单列表
 	OrderList(ctx context.Context, in *OrderListReq, opts ...grpc.CallOption) (*OrderListResp, error)
 	// 订单详情
 	OrderDetail(ctx context.Context, in *OrderDetailReq, opts ...grpc.CallOption) (*OrderDetailInfo, error)
 	// 更新订单状态
 	UpdateOrderStatus(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*OrderBaseResp, error)
+	// 评价已完成订单中的商品，每个订单行只能评价一次
+	ReviewOrderGoods(ctx context.Context, in *CreateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error)
+	// 订单发货，一个订单可以分多个包裹发货
+	CreateShipment(ctx context.Context, in *CreateShipmentReq, opts ...grpc.CallOption) (*ShipmentInfo, error)
+	// 订单的包裹和物流轨迹
+	ListShipments(ctx context.Context, in *ListShipmentsReq, opts ...grpc.CallOption) (*ListShipmentsResp, error)
+	// 创建优惠券模板
+	CreateCouponTemplate(ctx context.Context, in *CouponTemplateInfo, opts ...grpc.CallOption) (*CouponTemplateInfo, error)
+	// 给用户发放优惠券
+	IssueCoupon(ctx context.Context, in *IssueCouponReq, opts ...grpc.CallOption) (*UserCouponInfo, error)
+	// 用户优惠券列表
+	UserCouponList(ctx context.Context, in *UserCouponListReq, opts ...grpc.CallOption) (*UserCouponListResp, error)
 }
 
 type orderClient struct {
@@ -56,6 +77,15 @@ func (c *orderClient) CreateOrder(ctx context.Context, in *OrderReq, opts ...grp
 	return out, nil
 }
 
+func (c *orderClient) PreviewOrder(ctx context.Context, in *OrderReq, opts ...grpc.CallOption) (*OrderQuote, error) {
+	out := new(OrderQuote)
+	err := c.cc.Invoke(ctx, Order_PreviewOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) OrderList(ctx context.Context, in *OrderListReq, opts ...grpc.CallOption) (*OrderListResp, error) {
 	out := new(OrderListResp)
 	err := c.cc.Invoke(ctx, Order_OrderList_FullMethodName, in, out, opts...)
@@ -83,18 +113,86 @@ func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *OrderStatus, op
 	return out, nil
 }
 
+func (c *orderClient) ReviewOrderGoods(ctx context.Context, in *CreateReviewReq, opts ...grpc.CallOption) (*ReviewInfo, error) {
+	out := new(ReviewInfo)
+	err := c.cc.Invoke(ctx, Order_ReviewOrderGoods_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateShipment(ctx context.Context, in *CreateShipmentReq, opts ...grpc.CallOption) (*ShipmentInfo, error) {
+	out := new(ShipmentInfo)
+	err := c.cc.Invoke(ctx, Order_CreateShipment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListShipments(ctx context.Context, in *ListShipmentsReq, opts ...grpc.CallOption) (*ListShipmentsResp, error) {
+	out := new(ListShipmentsResp)
+	err := c.cc.Invoke(ctx, Order_ListShipments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateCouponTemplate(ctx context.Context, in *CouponTemplateInfo, opts ...grpc.CallOption) (*CouponTemplateInfo, error) {
+	out := new(CouponTemplateInfo)
+	err := c.cc.Invoke(ctx, Order_CreateCouponTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) IssueCoupon(ctx context.Context, in *IssueCouponReq, opts ...grpc.CallOption) (*UserCouponInfo, error) {
+	out := new(UserCouponInfo)
+	err := c.cc.Invoke(ctx, Order_IssueCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) UserCouponList(ctx context.Context, in *UserCouponListReq, opts ...grpc.CallOption) (*UserCouponListResp, error) {
+	out := new(UserCouponListResp)
+	err := c.cc.Invoke(ctx, Order_UserCouponList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility
 type OrderServer interface {
 	// 创建订单
 	CreateOrder(context.Context, *OrderReq) (*OrderBaseResp, error)
+	// 下单前预览订单金额
+	PreviewOrder(context.Context, *OrderReq) (*OrderQuote, error)
 	// 订单列表
 	OrderList(context.Context, *OrderListReq) (*OrderListResp, error)
 	// 订单详情
 	OrderDetail(context.Context, *OrderDetailReq) (*OrderDetailInfo, error)
 	// 更新订单状态
 	UpdateOrderStatus(context.Context, *OrderStatus) (*OrderBaseResp, error)
+	// 评价已完成订单中的商品，每个订单行只能评价一次
+	ReviewOrderGoods(context.Context, *CreateReviewReq) (*ReviewInfo, error)
+	// 订单发货，一个订单可以分多个包裹发货
+	CreateShipment(context.Context, *CreateShipmentReq) (*ShipmentInfo, error)
+	// 订单的包裹和物流轨迹
+	ListShipments(context.Context, *ListShipmentsReq) (*ListShipmentsResp, error)
+	// 创建优惠券模板
+	CreateCouponTemplate(context.Context, *CouponTemplateInfo) (*CouponTemplateInfo, error)
+	// 给用户发放优惠券
+	IssueCoupon(context.Context, *IssueCouponReq) (*UserCouponInfo, error)
+	// 用户优惠券列表
+	UserCouponList(context.Context, *UserCouponListReq) (*UserCouponListResp, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -105,6 +203,9 @@ type UnimplementedOrderServer struct {
 func (UnimplementedOrderServer) CreateOrder(context.Context, *OrderReq) (*OrderBaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServer) PreviewOrder(context.Context, *OrderReq) (*OrderQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOrder not implemented")
+}
 func (UnimplementedOrderServer) OrderList(context.Context, *OrderListReq) (*OrderListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderList not implemented")
 }
@@ -114,6 +215,24 @@ func (UnimplementedOrderServer) OrderDetail(context.Context, *OrderDetailReq) (*
 func (UnimplementedOrderServer) UpdateOrderStatus(context.Context, *OrderStatus) (*OrderBaseResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServer) ReviewOrderGoods(context.Context, *CreateReviewReq) (*ReviewInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewOrderGoods not implemented")
+}
+func (UnimplementedOrderServer) CreateShipment(context.Context, *CreateShipmentReq) (*ShipmentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedOrderServer) ListShipments(context.Context, *ListShipmentsReq) (*ListShipmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+}
+func (UnimplementedOrderServer) CreateCouponTemplate(context.Context, *CouponTemplateInfo) (*CouponTemplateInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCouponTemplate not implemented")
+}
+func (UnimplementedOrderServer) IssueCoupon(context.Context, *IssueCouponReq) (*UserCouponInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCoupon not implemented")
+}
+func (UnimplementedOrderServer) UserCouponList(context.Context, *UserCouponListReq) (*UserCouponListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCouponList not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 
 // UnsafeOrderServer may be embedded to opt out of forward compatibility for this service.
@@ -145,6 +264,24 @@ func _Order_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_PreviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).PreviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_PreviewOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).PreviewOrder(ctx, req.(*OrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_OrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OrderListReq)
 	if err := dec(in); err != nil {
@@ -199,6 +336,114 @@ func _Order_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_ReviewOrderGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ReviewOrderGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ReviewOrderGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ReviewOrderGoods(ctx, req.(*CreateReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateShipment(ctx, req.(*CreateShipmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShipmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListShipments(ctx, req.(*ListShipmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateCouponTemplate(ctx, req.(*CouponTemplateInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_IssueCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).IssueCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_IssueCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).IssueCoupon(ctx, req.(*IssueCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_UserCouponList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UserCouponList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_UserCouponList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UserCouponList(ctx, req.(*UserCouponListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -210,6 +455,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _Order_CreateOrder_Handler,
 		},
+		{
+			MethodName: "PreviewOrder",
+			Handler:    _Order_PreviewOrder_Handler,
+		},
 		{
 			MethodName: "OrderList",
 			Handler:    _Order_OrderList_Handler,
@@ -222,6 +471,30 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _Order_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "ReviewOrderGoods",
+			Handler:    _Order_ReviewOrderGoods_Handler,
+		},
+		{
+			MethodName: "CreateShipment",
+			Handler:    _Order_CreateShipment_Handler,
+		},
+		{
+			MethodName: "ListShipments",
+			Handler:    _Order_ListShipments_Handler,
+		},
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _Order_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "IssueCoupon",
+			Handler:    _Order_IssueCoupon_Handler,
+		},
+		{
+			MethodName: "UserCouponList",
+			Handler:    _Order_UserCouponList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
@@ -5,4 +5,4 @@ protoc ^
         --go-grpc_opt=paths=source_relative ^
         --grpc-gateway_out=. ^
         --grpc-gateway_opt=paths=source_relative ^
-        order.proto
\ No newline at end of file
+        order.proto cart.proto
\ No newline at end of file
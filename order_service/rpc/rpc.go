@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"auth"
 	"errors"
 	"fmt"
 	"order_service/config"
@@ -32,6 +33,9 @@ func InitSrvClient() error {
 		// 指定round_robin策略
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// 携带本服务的令牌，对方据此放行内部接口
+		grpc.WithChainUnaryInterceptor(auth.UnaryClientAuth(config.Conf.Name)),
+		grpc.WithChainStreamInterceptor(auth.StreamClientAuth(config.Conf.Name)),
 	)
 	if err != nil {
 		fmt.Printf("dial goods_srv failed, err:%v\n", err)
@@ -45,6 +49,9 @@ func InitSrvClient() error {
 		// 指定round_robin策略
 		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// 携带本服务的令牌，对方据此放行内部接口
+		grpc.WithChainUnaryInterceptor(auth.UnaryClientAuth(config.Conf.Name)),
+		grpc.WithChainStreamInterceptor(auth.StreamClientAuth(config.Conf.Name)),
 	)
 	if err != nil {
 		fmt.Printf("dial stock_srv failed, err:%v\n", err)
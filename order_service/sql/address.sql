@@ -0,0 +1,24 @@
+CREATE TABLE `xx_address`(
+                        `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                        `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                        `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                        `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                        `address_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '地址id',
+                        `user_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户id',
+                        `name` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '收货人',
+                        `phone` VARCHAR(11) NOT NULL DEFAULT '' COMMENT '收货人手机号',
+                        `region_code` CHAR(6) NOT NULL DEFAULT '' COMMENT '区县行政区划代码',
+                        `province` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '省',
+                        `city` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '市',
+                        `district` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '区县',
+                        `detail` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '详细地址',
+                        `is_default` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否默认地址',
+
+                        UNIQUE (address_id),
+                        INDEX (user_id),
+                        INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '收货地址表';
@@ -0,0 +1,19 @@
+CREATE TABLE `xx_cart`(
+                        `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                        `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                        `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                        `user_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户id',
+                        `goods_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '商品id',
+                        `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id，0为没有规格的商品',
+                        `room_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '加购所在直播间',
+                        `num` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '商品数量',
+                        `selected` tinyint(1) NOT NULL DEFAULT '1' COMMENT '是否选中结算',
+
+                        UNIQUE (user_id, goods_id, sku_id),
+                        INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '购物车表';
\ No newline at end of file
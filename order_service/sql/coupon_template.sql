@@ -0,0 +1,25 @@
+CREATE TABLE `xx_coupon_template`(
+                        `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                        `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                        `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                        `template_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '模板id',
+                        `name` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '名称',
+                        `type` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '类型：1立减 2折扣 3满减',
+                        `amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '立减/满减金额（分）',
+                        `rate` INT UNSIGNED NOT NULL DEFAULT '100' COMMENT '折扣率（百分比）',
+                        `threshold` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '使用门槛（分）',
+                        `max_discount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '折扣券最高优惠（分）',
+                        `total` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '发放总量',
+                        `issued` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '已发放数量',
+                        `per_user_limit` INT UNSIGNED NOT NULL DEFAULT '0' COMMENT '每人限领',
+                        `start_time` DATETIME NOT NULL COMMENT '有效期开始',
+                        `end_time` DATETIME NOT NULL COMMENT '有效期结束',
+
+                        UNIQUE (template_id),
+                        INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '优惠券模板表';
\ No newline at end of file
@@ -11,17 +11,26 @@ CREATE TABLE `xx_order`(
                         `order_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '订单id',
                         `trade_id` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '交易单号',
                         `pay_channel` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '支付方式',
-                        `status` INT UNSIGNED NOT NULL DEFAULT '0' COMMENT '订单状态:100创建订单/待支付 200已支付 300交易关闭 400完成',
+                        `status` INT UNSIGNED NOT NULL DEFAULT '0' COMMENT '订单状态:100创建订单/待支付 200已支付 250已发货 300交易关闭 400完成',
                         `pay_amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '支付金额（分）',
-                        `pay_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '支付时间',
+                        `original_amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '优惠前金额（分）',
+                        `discount_amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '优惠金额（分）',
+                        `discount_detail` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '优惠明细',
+                        `coupon_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '使用的用户优惠券id',
+                        `shipping_fee` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '运费（分）',
+                        `pay_time` DATETIME NULL DEFAULT NULL COMMENT '支付时间',
+                        `delivered_at` DATETIME NULL DEFAULT NULL COMMENT '全部签收时间',
 
-                        `receive_address` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '收货地址',
+                        `address_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '下单使用的收货地址id',
+                        `receive_address` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '收货地址，省市区和详细地址',
                         `receive_name` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '收货人',
                         `receive_phone` VARCHAR(11) NOT NULL DEFAULT '' COMMENT '收货人电话',
+                        `receive_region_code` CHAR(6) NOT NULL DEFAULT '' COMMENT '收货区县行政区划代码',
 
 
                         INDEX (user_id),
                         INDEX (order_id),
                         INDEX (trade_id),
+                        INDEX (status, delivered_at),
                         INDEX (is_del)
 )ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '订单表';
\ No newline at end of file
@@ -10,18 +10,22 @@ CREATE TABLE `xx_order_detail`(
                                  `user_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户id',
                                  `order_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '订单id',
                                  `goods_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '商品id',
+                                 `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id，0为没有规格的商品',
+                                 `room_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '下单所在直播间id',
 
                                  `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '名称',
+                                 `spec_text` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '规格描述',
                                  `market_price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '市场价/划线价（分）',
-                                 `price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '售价（分）',
+                                 `price` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '成交单价（分）',
                                  `brief` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '简介',
                                  `head_imgs` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '头图',
                                  `videos` VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '视频介绍',
                                  `detail` VARCHAR(2048) NOT NULL DEFAULT '' COMMENT '详情',
                                  `num` BIGINT(20) UNSIGNED NOT NULL COMMENT '商品数量',
 
-                                 `pay_amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '支付金额（分）',
-                                 `pay_time` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '支付时间',
+                                 `pay_amount` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '分摊优惠后的支付金额（分）',
+                                 `pay_time` DATETIME NULL DEFAULT NULL COMMENT '支付时间',
+                                 `shipment_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '包裹id，0为未发货',
 
                                  INDEX (order_id),
                                  INDEX (user_id),
@@ -0,0 +1,41 @@
+CREATE TABLE `xx_shipment`(
+                                 `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                                 `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                 `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                                 `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                                 `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                                 `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                                 `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                                 `shipment_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '包裹id',
+                                 `order_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '订单id',
+                                 `carrier` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '承运商编码',
+                                 `tracking_no` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '运单号',
+                                 `status` INT UNSIGNED NOT NULL DEFAULT '1' COMMENT '物流状态:1运输中 2已签收 3异常',
+                                 `shipped_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '发货时间',
+                                 `delivered_at` DATETIME NULL DEFAULT NULL COMMENT '签收时间',
+
+                                 UNIQUE (shipment_id),
+                                 UNIQUE (carrier, tracking_no),
+                                 INDEX (order_id),
+                                 INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '订单包裹表';
+
+CREATE TABLE `xx_shipment_event`(
+                                 `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                                 `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                 `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                                 `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                                 `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                                 `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                                 `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                                 `shipment_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '包裹id',
+                                 `status` INT UNSIGNED NOT NULL DEFAULT '1' COMMENT '物流状态:1运输中 2已签收 3异常',
+                                 `description` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '轨迹描述',
+                                 `location` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '所在地',
+                                 `event_time` DATETIME NOT NULL COMMENT '轨迹发生时间',
+
+                                 UNIQUE (shipment_id, event_time, status),
+                                 INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '包裹物流轨迹表';
@@ -0,0 +1,20 @@
+CREATE TABLE `xx_user_coupon`(
+                        `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                        `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                        `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                        `coupon_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户优惠券id',
+                        `template_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '模板id',
+                        `user_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户id',
+                        `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：0未使用 1已锁定 2已使用',
+                        `order_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '锁定/使用的订单id',
+
+                        UNIQUE (coupon_id),
+                        INDEX (user_id, template_id),
+                        INDEX (order_id),
+                        INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '用户优惠券表';
\ No newline at end of file
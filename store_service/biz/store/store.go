@@ -8,22 +8,23 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-func GetStoreByGoodsId(ctx context.Context, goodsId int64) (*proto.GoodsStoreInfo, error) {
-	data, err := mysql.GetStoreByGoodsId(ctx, goodsId)
+func GetStoreByGoodsId(ctx context.Context, goodsId, skuId int64) (*proto.GoodsStoreInfo, error) {
+	data, err := mysql.GetStoreByGoodsId(ctx, goodsId, skuId)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &proto.GoodsStoreInfo{
-		GoodsId: data.GoodsId,
+		GoodsId: goodsId,
+		SkuId:   skuId,
 		Num:     data.Num,
 	}
 
 	return resp, nil
 }
 
-func SetStoreByGoodsId(ctx context.Context, goodsId, num int64) (*proto.BaseResp, error) {
-	err := mysql.SetStoreByGoodsId(ctx, goodsId, num)
+func SetStoreByGoodsId(ctx context.Context, goodsId, skuId, num int64) (*proto.BaseResp, error) {
+	err := mysql.SetStoreByGoodsId(ctx, goodsId, skuId, num)
 	if err != nil {
 		return nil, err
 	}
@@ -34,17 +35,115 @@ func SetStoreByGoodsId(ctx context.Context, goodsId, num int64) (*proto.BaseResp
 	return resp, nil
 }
 
-func ReduceStore(ctx context.Context, goodsId, num, orderId int64) (*proto.GoodsStoreInfo, error) {
-	data, err := mysql.ReduceStore(ctx, goodsId, num, orderId)
+func ReduceStore(ctx context.Context, goodsId, skuId, num, orderId int64) (*proto.GoodsStoreInfo, error) {
+	data, err := mysql.ReduceStore(ctx, goodsId, skuId, num, orderId)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &proto.GoodsStoreInfo{
 		GoodsId: data.GoodsId,
+		SkuId:   data.SkuId,
 		Num:     data.Num,
 		OrderId: orderId,
 	}
 
 	return resp, nil
 }
+
+func GetFlashSaleStore(ctx context.Context, sessionId int64) (*proto.GoodsStoreInfo, error) {
+	data, err := mysql.GetFlashSaleStore(ctx, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.GoodsStoreInfo{
+		GoodsId:   data.GoodsId,
+		Num:       data.Num,
+		SessionId: sessionId,
+	}
+	return resp, nil
+}
+
+func SetFlashSaleStore(ctx context.Context, sessionId, goodsId, num int64) (*proto.BaseResp, error) {
+	err := mysql.SetFlashSaleStore(ctx, sessionId, goodsId, num)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.BaseResp{
+		Code: int32(codes.OK),
+		Msg:  "设置成功",
+	}
+	return resp, nil
+}
+
+func ReduceFlashSaleStore(ctx context.Context, sessionId, goodsId, skuId, num, orderId int64) (*proto.GoodsStoreInfo, error) {
+	data, err := mysql.ReduceFlashSaleStore(ctx, sessionId, goodsId, skuId, num, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.GoodsStoreInfo{
+		GoodsId:   data.GoodsId,
+		SkuId:     skuId,
+		Num:       data.Num,
+		OrderId:   orderId,
+		SessionId: sessionId,
+	}
+	return resp, nil
+}
+
+// storeKey 普通库存按商品和规格区分
+type storeKey struct {
+	goodsId int64
+	skuId   int64
+}
+
+// BatchGetStore 批量查询库存，带SessionId的查询秒杀场次库存，未设置库存的商品返回0
+func BatchGetStore(ctx context.Context, list []*proto.GoodsStoreInfo) (*proto.GoodsListStore, error) {
+	var goodsIds, sessionIds []int64
+	for _, item := range list {
+		if item.GetSessionId() > 0 {
+			sessionIds = append(sessionIds, item.GetSessionId())
+		} else {
+			goodsIds = append(goodsIds, item.GetGoodsId())
+		}
+	}
+
+	goodsNum := make(map[storeKey]int64, len(goodsIds))
+	if len(goodsIds) > 0 {
+		stores, err := mysql.GetStoreByGoodsIds(ctx, goodsIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stores {
+			goodsNum[storeKey{goodsId: s.GoodsId, skuId: s.SkuId}] = s.Num
+		}
+	}
+	sessionNum := make(map[int64]int64, len(sessionIds))
+	if len(sessionIds) > 0 {
+		stores, err := mysql.GetFlashSaleStoreBySessionIds(ctx, sessionIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stores {
+			sessionNum[s.SessionId] = s.Num
+		}
+	}
+
+	data := make([]*proto.GoodsStoreInfo, 0, len(list))
+	for _, item := range list {
+		info := &proto.GoodsStoreInfo{
+			GoodsId:   item.GetGoodsId(),
+			SkuId:     item.GetSkuId(),
+			SessionId: item.GetSessionId(),
+		}
+		if item.GetSessionId() > 0 {
+			info.Num = sessionNum[item.GetSessionId()]
+		} else {
+			info.Num = goodsNum[storeKey{goodsId: item.GetGoodsId(), skuId: item.GetSkuId()}]
+		}
+		data = append(data, info)
+	}
+	return &proto.GoodsListStore{Data: data}, nil
+}
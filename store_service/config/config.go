@@ -22,6 +22,14 @@ type Config struct {
 	*MySQLConfig  `mapstructure:"mysql"`
 	*ConsulConfig `mapstructure:"consul"`
 	*RedisConfig  `mapstructure:"redis"`
+
+	*AuthConfig `mapstructure:"auth"`
+}
+
+// AuthConfig 登录令牌，Secret与用户服务一致；ServiceSecret用于服务间调用，用户服务没有这个密钥
+type AuthConfig struct {
+	Secret        string `mapstructure:"secret"`         // 通过环境变量AUTH_SECRET设置
+	ServiceSecret string `mapstructure:"service_secret"` // 通过环境变量SERVICE_AUTH_SECRET设置
 }
 
 type LogConfig struct {
@@ -54,8 +62,19 @@ type RedisConfig struct {
 	PoolSize int    `mapstructure:"pool_size"`
 }
 
+// secretEnv 密钥不写入配置文件，从环境变量读取
+var secretEnv = map[string]string{
+	"auth.secret":         "AUTH_SECRET",
+	"auth.service_secret": "SERVICE_AUTH_SECRET",
+}
+
 func Init(filePath string) (err error) {
 	viper.SetConfigFile(filePath)
+	for key, env := range secretEnv {
+		if err = viper.BindEnv(key, env); err != nil {
+			return
+		}
+	}
 
 	err = viper.ReadInConfig()
 	if err != nil {
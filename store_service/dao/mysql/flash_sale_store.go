@@ -0,0 +1,146 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"store_service/dao/redis"
+	"store_service/model"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func GetFlashSaleStore(ctx context.Context, sessionId int64) (*model.FlashSaleStore, error) {
+	var data model.FlashSaleStore
+	err := db.WithContext(ctx).
+		Model(&model.FlashSaleStore{}).
+		Where("session_id = ? ", sessionId).
+		First(&data).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, errors.New("Query is failed!")
+	}
+	return &data, nil
+}
+
+// SetFlashSaleStore 设置秒杀场次的限量库存，场次库存不存在时新建
+func SetFlashSaleStore(ctx context.Context, sessionId, goodsId, num int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var data model.FlashSaleStore
+		err := tx.WithContext(ctx).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Model(&model.FlashSaleStore{}).
+			Where("session_id = ? ", sessionId).
+			First(&data).Error
+		if err == gorm.ErrRecordNotFound {
+			data = model.FlashSaleStore{
+				SessionId: sessionId,
+				GoodsId:   goodsId,
+				Num:       num,
+			}
+			return tx.WithContext(ctx).Create(&data).Error
+		}
+		if err != nil {
+			return err
+		}
+		if data.GoodsId != goodsId {
+			return errors.New("秒杀场次与商品不匹配")
+		}
+
+		err = tx.WithContext(ctx).
+			Model(&model.FlashSaleStore{}).
+			Where("session_id = ? ", sessionId).
+			Update("num", num).
+			Error
+		if err != nil {
+			zap.L().Error("SetFlashSaleStore save failed,", zap.Int64("session_id", sessionId))
+			return err
+		}
+		return nil
+	})
+}
+
+// ReduceFlashSaleStore 扣减秒杀场次的限量库存，不占用商品的普通库存
+// 秒杀场次的限量库存由商品的所有规格共用，库存记录中记下规格用于区分同一订单的不同规格
+func ReduceFlashSaleStore(ctx context.Context, sessionId, goodsId, skuId, num, orderId int64) (*model.FlashSaleStore, error) {
+	var data model.FlashSaleStore
+
+	mutexname := fmt.Sprintf("xx-flash-sale-store-%d", sessionId)
+	mutex := redis.Rs.NewMutex(mutexname)
+	if err := mutex.Lock(); err != nil {
+		return nil, errors.New("Get Redisync Failed!")
+	}
+	defer mutex.Unlock()
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.WithContext(ctx).
+			Model(&model.FlashSaleStore{}).
+			Where("session_id = ? and goods_id = ?", sessionId, goodsId).
+			First(&data).Error
+		if err != nil {
+			return err
+		}
+
+		if data.Num-num < 0 {
+			return errors.New("秒杀库存不足")
+		}
+
+		data.Num -= num
+		data.Lock += num
+
+		err = tx.WithContext(ctx).
+			Save(&data).
+			Error
+		if err != nil {
+			zap.L().Info("ReduceFlashSaleStore save failed", zap.Int64("session_id", sessionId))
+			return err
+		}
+
+		// 创建库存记录表，回滚时根据SessionId归还到场次库存
+		storeRecord := model.StoreRecord{
+			OrderId:   orderId,
+			GoodsId:   goodsId,
+			SkuId:     skuId,
+			SessionId: sessionId,
+			Num:       num,
+			Status:    1, // 预扣减
+		}
+		err = tx.WithContext(ctx).
+			Model(&model.StoreRecord{}).
+			Create(&storeRecord).Error
+		if err != nil {
+			zap.L().Error("create StoreRecord failed", zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// rollbackFlashSaleStore 在事务中将预扣的秒杀库存归还到场次
+func rollbackFlashSaleStore(ctx context.Context, tx *gorm.DB, sr *model.StoreRecord) error {
+	var s model.FlashSaleStore
+	err := tx.WithContext(ctx).
+		Model(&model.FlashSaleStore{}).
+		Where("session_id = ?", sr.SessionId).
+		First(&s).Error
+	if err != nil {
+		zap.L().Error("query flash sale stock by session_id failed", zap.Error(err), zap.Int64("session_id", sr.SessionId))
+		return err
+	}
+	s.Num += sr.Num
+	s.Lock -= sr.Num
+	if s.Lock < 0 {
+		return errors.New("回滚秒杀库存失败")
+	}
+	err = tx.WithContext(ctx).Save(&s).Error
+	if err != nil {
+		zap.L().Warn("RollbackStock flash sale stock save failed", zap.Int64("session_id", s.SessionId), zap.Error(err))
+		return err
+	}
+	return nil
+}
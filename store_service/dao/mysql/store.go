@@ -12,11 +12,11 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-func GetStoreByGoodsId(ctx context.Context, goodsId int64) (*model.Store, error) {
+func GetStoreByGoodsId(ctx context.Context, goodsId, skuId int64) (*model.Store, error) {
 	var data model.Store
 	err := db.WithContext(ctx).
 		Model(&model.Store{}).
-		Where("goods_id = ? ", goodsId).
+		Where("goods_id = ? and sku_id = ?", goodsId, skuId).
 		First(&data).Error
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, errors.New("Query is failed!")
@@ -24,16 +24,25 @@ func GetStoreByGoodsId(ctx context.Context, goodsId int64) (*model.Store, error)
 	return &data, nil
 }
 
-func SetStoreByGoodsId(ctx context.Context, goodsId, num int64) error {
+// SetStoreByGoodsId 设置商品库存，规格的库存不存在时新建
+func SetStoreByGoodsId(ctx context.Context, goodsId, skuId, num int64) error {
 	// 采用悲观锁实现
 	var data model.Store
 
 	err := db.Transaction(func(tx *gorm.DB) error {
 		err := tx.WithContext(ctx).
 			Model(&model.Store{}).
-			Where("goods_id = ? ", goodsId).
+			Where("goods_id = ? and sku_id = ?", goodsId, skuId).
 			First(&data).Error
 
+		if err == gorm.ErrRecordNotFound && skuId > 0 {
+			data = model.Store{
+				GoodsId: goodsId,
+				SkuId:   skuId,
+				Num:     num,
+			}
+			return tx.WithContext(ctx).Create(&data).Error
+		}
 		if err != nil {
 			return err
 		}
@@ -41,7 +50,7 @@ func SetStoreByGoodsId(ctx context.Context, goodsId, num int64) error {
 		err = tx.WithContext(ctx).
 			Clauses(clause.Locking{Strength: "UPDATE"}).
 			Model(&model.Store{}).
-			Where("goods_id = ? ", data.GoodsId).
+			Where("goods_id = ? and sku_id = ?", data.GoodsId, data.SkuId).
 			Update("num", num).
 			Error
 
@@ -135,10 +144,10 @@ func SetStoreByGoodsId(ctx context.Context, goodsId, num int64) error {
 	return &data, nil
 } */
 
-func ReduceStore(ctx context.Context, goodsId, num, orderId int64) (*model.Store, error) {
+func ReduceStore(ctx context.Context, goodsId, skuId, num, orderId int64) (*model.Store, error) {
 	var data model.Store
 
-	mutexname := fmt.Sprintf("xx-store-%d", goodsId)
+	mutexname := fmt.Sprintf("xx-store-%d-%d", goodsId, skuId)
 	mutex := redis.Rs.NewMutex(mutexname)
 	if err := mutex.Lock(); err != nil {
 		return nil, errors.New("Get Redisync Failed!")
@@ -148,6 +157,7 @@ func ReduceStore(ctx context.Context, goodsId, num, orderId int64) (*model.Store
 	err := db.Transaction(func(tx *gorm.DB) error {
 		err := tx.WithContext(ctx).
 			Model(&model.Store{}).
+			Where("goods_id = ? and sku_id = ?", goodsId, skuId).
 			First(&data).Error
 		if err != nil {
 			return err
@@ -172,6 +182,7 @@ func ReduceStore(ctx context.Context, goodsId, num, orderId int64) (*model.Store
 		storeRecord := model.StoreRecord{
 			OrderId: orderId,
 			GoodsId: goodsId,
+			SkuId:   skuId,
 			Num:     num,
 			Status:  1, // 预扣减
 		}
@@ -192,51 +203,95 @@ func ReduceStore(ctx context.Context, goodsId, num, orderId int64) (*model.Store
 }
 
 // RollbackStock 监听rocketmq消息进行库存回滚
+// 消息中GoodsId为0时回滚整个订单所有预扣减的库存
 func RollbackStockByMsg(ctx context.Context, data model.OrderGoodsStockInfo) error {
 	// 先查询库存数据，需要放到事务操作中
-	db.Transaction(func(tx *gorm.DB) error {
-		var sr model.StoreRecord
-		err := tx.WithContext(ctx).
+	return db.Transaction(func(tx *gorm.DB) error {
+		var srList []*model.StoreRecord
+		query := tx.WithContext(ctx).
 			Model(&model.StoreRecord{}).
-			Where("order_id = ? and goods_id = ? and status = 1", data.OrderId, data.GoodsId).
-			First(&sr).Error
-		// 没找到记录
-		// 压根就没记录或者已经回滚过 不需要后续操作
-		if err == gorm.ErrRecordNotFound {
-			return nil
+			Where("order_id = ? and status = 1", data.OrderId)
+		if data.GoodsId > 0 {
+			query = query.Where("goods_id = ?", data.GoodsId)
 		}
-		if err != nil {
+		err := query.Find(&srList).Error
+		if err != nil && err != gorm.ErrEmptySlice {
 			zap.L().Error("query stock_record by order_id failed", zap.Error(err), zap.Int64("order_id", data.OrderId), zap.Int64("goods_id", data.GoodsId))
 			return err
 		}
-		// 开始归还库存
-		var s model.Store
-		err = tx.WithContext(ctx).
-			Model(&model.Store{}).
-			Where("goods_id = ?", data.GoodsId).
-			First(&s).Error
-		if err != nil {
-			zap.L().Error("query stock by goods_id failed", zap.Error(err), zap.Int64("goods_id", data.GoodsId))
-			return err
-		}
-		s.Num += data.Num  // 库存加上
-		s.Lock -= data.Num // 锁定的库存减掉
-		if s.Lock < 0 {    // 预扣库存不能为负
-			return errors.New("回滚库存失败")
+		// 没找到记录
+		// 压根就没记录或者已经回滚过 不需要后续操作
+		for _, sr := range srList {
+			if err := rollbackStoreRecord(ctx, tx, sr); err != nil {
+				return err
+			}
 		}
-		err = tx.WithContext(ctx).Save(&s).Error
-		if err != nil {
-			zap.L().Warn("RollbackStock stock save failed", zap.Int64("goods_id", s.GoodsId), zap.Error(err))
+		return nil
+	})
+}
+
+// rollbackStoreRecord 在事务中归还一条预扣减记录的库存
+func rollbackStoreRecord(ctx context.Context, tx *gorm.DB, sr *model.StoreRecord) error {
+	// 秒杀场次的预扣库存归还到场次库存
+	if sr.SessionId > 0 {
+		if err := rollbackFlashSaleStore(ctx, tx, sr); err != nil {
 			return err
 		}
-		// 将库存扣减记录的状态变更为已回滚
 		sr.Status = 3
-		err = tx.WithContext(ctx).Save(&sr).Error
-		if err != nil {
-			zap.L().Warn("RollbackStock stock_record save failed", zap.Int64("goods_id", s.GoodsId), zap.Error(err))
-			return err
-		}
-		return nil
-	})
+		return tx.WithContext(ctx).Save(sr).Error
+	}
+	// 开始归还库存
+	var s model.Store
+	err := tx.WithContext(ctx).
+		Model(&model.Store{}).
+		Where("goods_id = ? and sku_id = ?", sr.GoodsId, sr.SkuId).
+		First(&s).Error
+	if err != nil {
+		zap.L().Error("query stock by goods_id failed", zap.Error(err), zap.Int64("goods_id", sr.GoodsId))
+		return err
+	}
+	s.Num += sr.Num  // 库存加上
+	s.Lock -= sr.Num // 锁定的库存减掉
+	if s.Lock < 0 {  // 预扣库存不能为负
+		return errors.New("回滚库存失败")
+	}
+	err = tx.WithContext(ctx).Save(&s).Error
+	if err != nil {
+		zap.L().Warn("RollbackStock stock save failed", zap.Int64("goods_id", s.GoodsId), zap.Error(err))
+		return err
+	}
+	// 将库存扣减记录的状态变更为已回滚
+	sr.Status = 3
+	err = tx.WithContext(ctx).Save(sr).Error
+	if err != nil {
+		zap.L().Warn("RollbackStock stock_record save failed", zap.Int64("goods_id", s.GoodsId), zap.Error(err))
+		return err
+	}
 	return nil
 }
+
+// GetStoreByGoodsIds 批量查询商品库存，包括商品下所有规格的库存
+func GetStoreByGoodsIds(ctx context.Context, idList []int64) ([]*model.Store, error) {
+	var data []*model.Store
+	err := db.WithContext(ctx).
+		Model(&model.Store{}).
+		Where("goods_id in ?", idList).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, errors.New("Query is failed!")
+	}
+	return data, nil
+}
+
+// GetFlashSaleStoreBySessionIds 批量查询秒杀场次库存
+func GetFlashSaleStoreBySessionIds(ctx context.Context, idList []int64) ([]*model.FlashSaleStore, error) {
+	var data []*model.FlashSaleStore
+	err := db.WithContext(ctx).
+		Model(&model.FlashSaleStore{}).
+		Where("session_id in ?", idList).
+		Find(&data).Error
+	if err != nil && err != gorm.ErrEmptySlice {
+		return nil, errors.New("Query is failed!")
+	}
+	return data, nil
+}
@@ -27,9 +27,18 @@ func (s *StoreSrv) SetStore(ctx context.Context, req *proto.GoodsStoreInfo) (*pr
 	if req.GetNum() < 0 {
 		return nil, status.Error(codes.InvalidArgument, "Num参数错误，不能为复数")
 	}
-	data, err := store.SetStoreByGoodsId(ctx, req.GetGoodsId(), req.GetNum())
+	var (
+		data *proto.BaseResp
+		err  error
+	)
+	if req.GetSessionId() > 0 {
+		// 设置秒杀场次的限量库存
+		data, err = store.SetFlashSaleStore(ctx, req.GetSessionId(), req.GetGoodsId(), req.GetNum())
+	} else {
+		data, err = store.SetStoreByGoodsId(ctx, req.GetGoodsId(), req.GetSkuId(), req.GetNum())
+	}
 
-	if err.Error() == "record not found" {
+	if err != nil && err.Error() == "record not found" {
 		zap.L().Error("GetStore failed:", zap.Int64("goods_id", req.GoodsId), zap.Error(err))
 		return nil, status.Error(codes.InvalidArgument, "未查询到商品Id")
 	}
@@ -44,7 +53,15 @@ func (s *StoreSrv) GetStore(ctx context.Context, req *proto.GoodsStoreInfo) (*pr
 	if req.GetGoodsId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "参数错误")
 	}
-	data, err := store.GetStoreByGoodsId(ctx, req.GetGoodsId())
+	var (
+		data *proto.GoodsStoreInfo
+		err  error
+	)
+	if req.GetSessionId() > 0 {
+		data, err = store.GetFlashSaleStore(ctx, req.GetSessionId())
+	} else {
+		data, err = store.GetStoreByGoodsId(ctx, req.GetGoodsId(), req.GetSkuId())
+	}
 	if err != nil {
 		zap.L().Error("GetStore failed:", zap.Int64("goods_id", req.GoodsId), zap.Error(err))
 		return nil, status.Error(codes.Internal, "内部错误")
@@ -56,7 +73,16 @@ func (s *StoreSrv) ReduceStore(ctx context.Context, req *proto.GoodsStoreInfo) (
 	if req.GetGoodsId() <= 0 || req.GetNum() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "无效的参数")
 	}
-	data, err := store.ReduceStore(ctx, req.GetGoodsId(), req.GetNum(), req.OrderId)
+	var (
+		data *proto.GoodsStoreInfo
+		err  error
+	)
+	if req.GetSessionId() > 0 {
+		// 秒杀场次扣减场次限量库存
+		data, err = store.ReduceFlashSaleStore(ctx, req.GetSessionId(), req.GetGoodsId(), req.GetSkuId(), req.GetNum(), req.GetOrderId())
+	} else {
+		data, err = store.ReduceStore(ctx, req.GetGoodsId(), req.GetSkuId(), req.GetNum(), req.OrderId)
+	}
 	if err != nil {
 		zap.L().Error("ReduceStore failed:", zap.Int64("goods_id", req.GoodsId), zap.Error(err))
 		return nil, status.Error(codes.Internal, "扣减库存失败")
@@ -64,6 +90,24 @@ func (s *StoreSrv) ReduceStore(ctx context.Context, req *proto.GoodsStoreInfo) (
 	return data, nil
 }
 
+// BatchGetStore 批量查询商品库存
+func (s *StoreSrv) BatchGetStore(ctx context.Context, req *proto.GoodsListStore) (*proto.GoodsListStore, error) {
+	if len(req.GetData()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "参数错误")
+	}
+	for _, item := range req.GetData() {
+		if item.GetGoodsId() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "GoodsId参数错误")
+		}
+	}
+	data, err := store.BatchGetStore(ctx, req.GetData())
+	if err != nil {
+		zap.L().Error("BatchGetStore failed:", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
 func RollbackMsghandle(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 	for i := range msgs {
 		// zap.L().Info("hanlder.RollbackMsghandle start...")
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"auth"
 	"context"
 	"flag"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"store_service/dao/redis"
 	"store_service/handler"
 	"store_service/logger"
+	"store_service/middleware"
 	"store_service/proto"
 	"store_service/registry"
 	"syscall"
@@ -80,7 +82,17 @@ func main() {
 		panic(err)
 	}
 
-	s := grpc.NewServer()
+	// 校验登录令牌和服务间调用令牌
+	if err := auth.Init(config.Conf.AuthConfig.Secret); err != nil {
+		panic(err)
+	}
+	if err := auth.InitService(config.Conf.AuthConfig.ServiceSecret); err != nil {
+		panic(err)
+	}
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryAuth(middleware.Policy)),
+		grpc.ChainStreamInterceptor(auth.StreamAuth(middleware.Policy)),
+	)
 	// 注册健康检查服务，至此consul来对我进行检查
 	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
 	proto.RegisterStoreServer(s, &handler.StoreSrv{})
@@ -129,7 +141,7 @@ func main() {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
 	serviceId := fmt.Sprintf("%s-%s-%d", config.Conf.Name, config.Conf.Ip, config.Conf.RpcPort)
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"auth"
+	"store_service/proto"
+)
+
+// Policy 库存服务各方法的访问规则，新增方法时需要在这里声明，未声明的方法一律拒绝
+var Policy = auth.Policy{
+	proto.Store_GetStore_FullMethodName: auth.Public,
+	proto.Store_SetStore_FullMethodName: auth.RequireRoles(auth.RoleMerchant),
+
+	// 扣减和回滚库存只能由订单服务在下单流程中调用
+	proto.Store_BatchGetStore_FullMethodName:    auth.Internal,
+	proto.Store_ReduceStore_FullMethodName:      auth.Internal,
+	proto.Store_BatchReduceStore_FullMethodName: auth.Internal,
+	proto.Store_RollbackStore_FullMethodName:    auth.Internal,
+}
@@ -12,7 +12,7 @@ type BaseModel struct {
 	IsDel    int8 `gorm:"index"`
 }
 
-// OrderGoodsStockInfo 订单库存记录
+// OrderGoodsStockInfo 订单库存记录，GoodsId为0时表示整个订单
 type OrderGoodsStockInfo struct {
 	OrderId int64
 	GoodsId int64
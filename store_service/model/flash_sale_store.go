@@ -0,0 +1,15 @@
+package model
+
+// FlashSaleStore 秒杀场次限量库存，与商品的普通库存分开扣减
+type FlashSaleStore struct {
+	BaseModel
+
+	SessionId int64
+	GoodsId   int64
+	Num       int64
+	Lock      int64
+}
+
+func (FlashSaleStore) TableName() string {
+	return "xx_flash_sale_store"
+}
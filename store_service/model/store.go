@@ -4,6 +4,7 @@ type Store struct {
 	BaseModel
 
 	GoodsId int64
+	SkuId   int64 // 0表示没有规格的商品
 	Num     int64
 	Lock    int64
 }
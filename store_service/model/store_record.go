@@ -3,10 +3,12 @@ package model
 type StoreRecord struct {
 	BaseModel // 嵌入默认的7个字段
 
-	OrderId int64
-	GoodsId int64
-	Num     int64
-	Status  int32
+	OrderId   int64
+	GoodsId   int64
+	SkuId     int64
+	SessionId int64 // 秒杀场次id，0表示扣减的是普通库存
+	Num       int64
+	Status    int32
 }
 
 // TableName 声明表名
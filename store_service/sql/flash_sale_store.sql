@@ -0,0 +1,16 @@
+CREATE TABLE `xx_flash_sale_store`(
+                           `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                           `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                           `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                           `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                           `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                           `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                           `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                           `session_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '秒杀场次id',
+                           `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT 'goods id',
+                           `num` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '场次限量库存',
+                           `lock` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '预扣库存',
+                           UNIQUE (session_id),
+                           INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '秒杀场次库存表';
\ No newline at end of file
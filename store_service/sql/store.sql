@@ -8,8 +8,9 @@ CREATE TABLE `xx_store`(
                            `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
 
                            `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT 'goods id',
+                           `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id，0为没有规格的商品',
                            `num` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '库存',
                            `lock` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '预扣库存',
-                           UNIQUE (goods_id),
+                           UNIQUE (goods_id, sku_id),
                            INDEX (is_del)
 )ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '库存表';
\ No newline at end of file
@@ -9,8 +9,10 @@ CREATE TABLE `xx_store_record`(
 
                            `order_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '订单id',
                            `goods_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT 'goods id',
+                           `sku_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '规格id',
+                           `session_id` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '秒杀场次id，0为普通库存',
                            `num` BIGINT(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT 'num',
                            `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态：1预扣减 2扣减 3已回滚',
-                           UNIQUE (order_id, goods_id),
+                           UNIQUE (order_id, goods_id, sku_id),
                            INDEX (is_del)
 )ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '库存记录表';
\ No newline at end of file
@@ -0,0 +1,176 @@
+package user
+
+import (
+	"auth"
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"user_service/config"
+	"user_service/dao/mysql"
+	"user_service/model"
+	"user_service/proto"
+	"user_service/third_party/snowflake"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrUserNotFound  = mysql.ErrUserNotFound
+	ErrMobileExists  = mysql.ErrMobileExists
+	ErrInvalidMobile = errors.New("手机号格式有误")
+	ErrWeakPassword  = errors.New("密码长度需在8-32位之间")
+	ErrInvalidName   = errors.New("昵称长度不能超过32")
+	ErrLoginFailed   = errors.New("手机号或密码错误")
+	ErrUserDisabled  = errors.New("账号已被禁用")
+	ErrInvalidRole   = errors.New("角色有误")
+)
+
+var mobileRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+// 令牌带有角色，角色变更后最迟在令牌过期时生效，有效期不宜过长
+const (
+	defaultTokenExpire = 2 * time.Hour  // 未配置有效期时的默认值
+	maxTokenExpire     = 24 * time.Hour // 配置的有效期超过时按该值签发
+)
+
+// Register 手机号注册，注册成功直接签发令牌
+func Register(ctx context.Context, req *proto.RegisterReq) (*proto.LoginResp, error) {
+	mobile := strings.TrimSpace(req.GetMobile())
+	if !mobileRegexp.MatchString(mobile) {
+		return nil, ErrInvalidMobile
+	}
+	// bcrypt只取前72字节
+	if n := len(req.GetPassword()); n < 8 || n > 32 {
+		return nil, ErrWeakPassword
+	}
+	nickname := strings.TrimSpace(req.GetNickname())
+	if utf8.RuneCountInString(nickname) > 32 {
+		return nil, ErrInvalidName
+	}
+	if nickname == "" {
+		nickname = "用户" + mobile[len(mobile)-4:]
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.GetPassword()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &model.User{
+		UserId:   snowflake.GenID(),
+		Mobile:   mobile,
+		Password: string(hash),
+		Nickname: nickname,
+		Roles:    auth.RoleBuyer,
+		Status:   model.UserStatusNormal,
+	}
+	data.CreateBy = mobile
+	data.UpdateBy = mobile
+	if err := mysql.CreateUser(ctx, data); err != nil {
+		return nil, err
+	}
+	return login(data)
+}
+
+// Login 手机号密码登录，手机号不存在和密码错误返回相同的错误
+func Login(ctx context.Context, req *proto.LoginReq) (*proto.LoginResp, error) {
+	data, err := mysql.GetUserByMobile(ctx, strings.TrimSpace(req.GetMobile()))
+	if errors.Is(err, mysql.ErrUserNotFound) {
+		return nil, ErrLoginFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(data.Password), []byte(req.GetPassword())) != nil {
+		return nil, ErrLoginFailed
+	}
+	if data.Status == model.UserStatusDisabled {
+		return nil, ErrUserDisabled
+	}
+	return login(data)
+}
+
+// Info 用户信息
+func Info(ctx context.Context, userId int64) (*proto.UserInfo, error) {
+	data, err := mysql.GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return toUserInfo(data), nil
+}
+
+// SetRoles 覆盖用户的角色，已签发的令牌不受影响，用户重新登录后生效
+func SetRoles(ctx context.Context, userId int64, roles []string, operator int64) (*proto.UserInfo, error) {
+	if len(roles) == 0 {
+		return nil, ErrInvalidRole
+	}
+	seen := make(map[string]struct{}, len(roles))
+	list := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if !validRole(role) {
+			return nil, ErrInvalidRole
+		}
+		if _, ok := seen[role]; ok {
+			continue
+		}
+		seen[role] = struct{}{}
+		list = append(list, role)
+	}
+	data, err := mysql.GetUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	data.Roles = strings.Join(list, ",")
+	if err := mysql.SetUserRoles(ctx, userId, data.Roles, strconv.FormatInt(operator, 10)); err != nil {
+		return nil, err
+	}
+	return toUserInfo(data), nil
+}
+
+func validRole(role string) bool {
+	for _, r := range auth.UserRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// userRoles 用户的角色，历史数据没有角色时视为买家
+func userRoles(data *model.User) []string {
+	if data.Roles == "" {
+		return []string{auth.RoleBuyer}
+	}
+	return strings.Split(data.Roles, ",")
+}
+
+func login(data *model.User) (*proto.LoginResp, error) {
+	expire := defaultTokenExpire
+	if cfg := config.Conf.AuthConfig; cfg != nil && cfg.Expire > 0 {
+		expire = time.Duration(cfg.Expire) * time.Hour
+	}
+	if expire > maxTokenExpire {
+		expire = maxTokenExpire
+	}
+	token, expireAt, err := auth.GenToken(data.UserId, userRoles(data), expire)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LoginResp{
+		Token:    token,
+		ExpireAt: expireAt.Unix(),
+		User:     toUserInfo(data),
+	}, nil
+}
+
+func toUserInfo(data *model.User) *proto.UserInfo {
+	return &proto.UserInfo{
+		UserId:   data.UserId,
+		Mobile:   data.Mobile[:3] + "****" + data.Mobile[7:],
+		Nickname: data.Nickname,
+		Roles:    userRoles(data),
+	}
+}
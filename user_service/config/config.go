@@ -0,0 +1,87 @@
+package config
+
+import (
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var Conf = new(Config)
+
+type Config struct {
+	Name      string `mapstructure:"name"`
+	Mode      string `mapstructure:"mode"`
+	Ip        string `mapstructure:"ip"`
+	RpcPort   int    `mapstructure:"rpcPort"`
+	HttpPort  int    `mapstructure:"httpPort"`
+	Version   string `mapstructure:"version"`
+	StartTime string `mapstructure:"start_time"`
+	MachineId int64  `mapstructure:"machine_id"`
+
+	*LogConfig    `mapstructure:"log"`
+	*MySQLConfig  `mapstructure:"mysql"`
+	*ConsulConfig `mapstructure:"consul"`
+
+	*AuthConfig `mapstructure:"auth"`
+}
+
+type LogConfig struct {
+	Level      string `mapstructure:"level"`
+	Filename   string `mapstructure:"filename"`
+	MaxSize    int    `mapstructure:"max_size"`
+	MaxAge     int    `mapstructure:"max_age"`
+	MaxBackups int    `mapstructure:"max_backups"`
+}
+
+type MySQLConfig struct {
+	Host         string `mapstructure:"host"`
+	Port         int    `mapstructure:"port"`
+	User         string `mapstructure:"user"`
+	Password     string `mapstructure:"password"`
+	DbName       string `mapstructure:"dbname"`
+	MaxOpenConns int    `mapstructure:"max_open_conns"`
+	MaxIdleConns int    `mapstructure:"max_idle_conns"`
+}
+
+type ConsulConfig struct {
+	Address string `mapstructure:"address"`
+}
+
+// AuthConfig 登录令牌，其他服务使用同一个Secret校验用户令牌；服务间调用的令牌另用密钥，用户服务没有
+type AuthConfig struct {
+	Secret string `mapstructure:"secret"` // 通过环境变量AUTH_SECRET设置
+	Expire int    `mapstructure:"expire"` // 令牌有效期（小时），最长24小时
+}
+
+// secretEnv 密钥不写入配置文件，从环境变量读取
+var secretEnv = map[string]string{
+	"auth.secret": "AUTH_SECRET",
+}
+
+func Init(filePath string) (err error) {
+	viper.SetConfigFile(filePath)
+	for key, env := range secretEnv {
+		if err = viper.BindEnv(key, env); err != nil {
+			return
+		}
+	}
+
+	err = viper.ReadInConfig()
+	if err != nil {
+		zap.L().Info("viper.ReadInConfig failed", zap.Error(err))
+		return
+	}
+
+	if err := viper.Unmarshal(Conf); err != nil {
+		zap.L().Info("viper.Umarshal failed", zap.Error(err))
+	}
+
+	viper.WatchConfig()
+	viper.OnConfigChange(func(in fsnotify.Event) {
+		zap.L().Info("配置文件修改了！")
+		if err := viper.Unmarshal(Conf); err != nil {
+			zap.L().Info("viper.Umarshal failed", zap.Error(err))
+		}
+	})
+	return
+}
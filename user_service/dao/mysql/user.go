@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"user_service/model"
+
+	driver "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserNotFound = errors.New("用户不存在")
+	ErrMobileExists = errors.New("手机号已注册")
+)
+
+func CreateUser(ctx context.Context, data *model.User) error {
+	err := db.WithContext(ctx).Create(data).Error
+	if isDuplicate(err) {
+		return ErrMobileExists
+	}
+	return err
+}
+
+func GetUserByMobile(ctx context.Context, mobile string) (*model.User, error) {
+	var data model.User
+	err := db.WithContext(ctx).
+		Where("mobile = ? and is_del = 0", mobile).
+		First(&data).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func GetUser(ctx context.Context, userId int64) (*model.User, error) {
+	var data model.User
+	err := db.WithContext(ctx).
+		Where("user_id = ? and is_del = 0", userId).
+		First(&data).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// SetUserRoles 覆盖用户的角色
+func SetUserRoles(ctx context.Context, userId int64, roles, operator string) error {
+	return db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("user_id = ? and is_del = 0", userId).
+		Updates(map[string]interface{}{
+			"roles":     roles,
+			"update_by": operator,
+		}).Error
+}
+
+// isDuplicate 是否违反唯一索引
+func isDuplicate(err error) bool {
+	var mysqlErr *driver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"auth"
+	"context"
+	"errors"
+	"user_service/biz/user"
+	"user_service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserSrv struct {
+	proto.UnimplementedUserServer
+}
+
+// Register 注册
+func (s *UserSrv) Register(ctx context.Context, req *proto.RegisterReq) (*proto.LoginResp, error) {
+	data, err := user.Register(ctx, req)
+	if errors.Is(err, user.ErrInvalidMobile) || errors.Is(err, user.ErrWeakPassword) || errors.Is(err, user.ErrInvalidName) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, user.ErrMobileExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("user.Register failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// Login 登录
+func (s *UserSrv) Login(ctx context.Context, req *proto.LoginReq) (*proto.LoginResp, error) {
+	if len(req.GetMobile()) == 0 || len(req.GetPassword()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	data, err := user.Login(ctx, req)
+	if errors.Is(err, user.ErrLoginFailed) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if errors.Is(err, user.ErrUserDisabled) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("user.Login failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// GetUserInfo 当前登录用户的信息
+func (s *UserSrv) GetUserInfo(ctx context.Context, req *proto.GetUserInfoReq) (*proto.UserInfo, error) {
+	userId, ok := auth.UserId(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "请先登录")
+	}
+	data, err := user.Info(ctx, userId)
+	if errors.Is(err, user.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("user.Info failed", zap.Int64("user_id", userId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
+
+// SetUserRoles 设置用户的角色
+func (s *UserSrv) SetUserRoles(ctx context.Context, req *proto.SetUserRolesReq) (*proto.UserInfo, error) {
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "请求参数有误")
+	}
+	operator, _ := auth.UserId(ctx)
+	data, err := user.SetRoles(ctx, req.GetUserId(), req.GetRoles(), operator)
+	if errors.Is(err, user.ErrInvalidRole) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, user.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("user.SetRoles failed", zap.Int64("user_id", req.GetUserId()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "内部错误")
+	}
+	return data, nil
+}
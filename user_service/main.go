@@ -0,0 +1,117 @@
+package main
+
+import (
+	"auth"
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"user_service/config"
+	"user_service/dao/mysql"
+	"user_service/handler"
+	"user_service/logger"
+	"user_service/middleware"
+	"user_service/proto"
+	"user_service/registry"
+	"user_service/third_party/snowflake"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	var cfn string
+	flag.StringVar(&cfn, "conf", "./conf/config.yaml", "指定配置文件")
+	flag.Parse()
+
+	if err := config.Init(cfn); err != nil {
+		panic(err)
+	}
+
+	if err := logger.Init(config.Conf.LogConfig, config.Conf.Mode); err != nil {
+		panic(err)
+	}
+
+	if err := mysql.Init(config.Conf.MySQLConfig); err != nil {
+		panic(err)
+	}
+
+	if err := registry.Init(config.Conf.ConsulConfig.Address); err != nil {
+		panic(err)
+	}
+
+	if err := snowflake.Init(config.Conf.StartTime, config.Conf.MachineId); err != nil {
+		panic(err)
+	}
+
+	// 登录令牌的密钥
+	if err := auth.Init(config.Conf.AuthConfig.Secret); err != nil {
+		panic(err)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Conf.RpcPort))
+	if err != nil {
+		panic(err)
+	}
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryAuth(middleware.Policy)),
+		grpc.ChainStreamInterceptor(auth.StreamAuth(middleware.Policy)),
+	)
+	// 注册健康检查服务，至此consul来对我进行检查
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	proto.RegisterUserServer(s, &handler.UserSrv{})
+
+	go func() {
+		err := s.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	registry.Reg.RegisterService(config.Conf.Name, config.Conf.Ip, config.Conf.RpcPort, nil)
+	zap.L().Sugar().Infof("Service start at consul: %s-%s-%d", config.Conf.Name, config.Conf.Ip, config.Conf.RpcPort)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		fmt.Sprintf("%s:%d", config.Conf.Ip, config.Conf.RpcPort),
+		grpc.WithBlock(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		zap.L().Fatal("Failed to dial server:", zap.Error(err))
+	}
+
+	gwmux := runtime.NewServeMux()
+	err = proto.RegisterUserHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		zap.L().Fatal("Failed to register gateway:", zap.Error(err))
+	}
+
+	gwServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.Conf.HttpPort),
+		Handler: gwmux,
+	}
+	zap.S().Infof("Serving gRPC-GateWay on http://0.0.0.0:%d", config.Conf.HttpPort)
+
+	go func() {
+		err := gwServer.ListenAndServe()
+		if err != nil {
+			zap.S().Infof("gwServer.ListenAndServe failed, err:%v", err)
+			return
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
+	<-quit
+	serviceId := fmt.Sprintf("%s-%s-%d", config.Conf.Name, config.Conf.Ip, config.Conf.RpcPort)
+	registry.Reg.Deregister(serviceId)
+}
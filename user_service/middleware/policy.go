@@ -0,0 +1,14 @@
+package middleware
+
+import (
+	"auth"
+	"user_service/proto"
+)
+
+// Policy 用户服务各方法的访问规则，新增方法时需要在这里声明，未声明的方法一律拒绝
+var Policy = auth.Policy{
+	proto.User_Register_FullMethodName:     auth.Public,
+	proto.User_Login_FullMethodName:        auth.Public,
+	proto.User_GetUserInfo_FullMethodName:  auth.Login,
+	proto.User_SetUserRoles_FullMethodName: auth.RequireRoles(auth.RoleAdmin),
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type BaseModel struct {
+	ID       uint      `gorm:"primaryKey"`
+	CreateAt time.Time `gorm:"autoCreateTime"`
+	UpdateAt time.Time `gorm:"autoUpdateTime"`
+	CreateBy string
+	UpdateBy string
+	Version  int16
+	IsDel    int8 `gorm:"index"`
+}
@@ -0,0 +1,22 @@
+package model
+
+// 用户状态
+const (
+	UserStatusNormal   = 0
+	UserStatusDisabled = 1 // 禁用，不能登录
+)
+
+type User struct {
+	BaseModel
+
+	UserId   int64
+	Mobile   string
+	Password string // bcrypt哈希
+	Nickname string
+	Roles    string // 逗号分隔，注册时为buyer
+	Status   int8
+}
+
+func (User) TableName() string {
+	return "xx_user"
+}
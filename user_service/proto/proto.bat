@@ -0,0 +1,8 @@
+protoc ^
+        --go_out=. ^
+        --go_opt=paths=source_relative ^
+        --go-grpc_out=. ^
+        --go-grpc_opt=paths=source_relative ^
+        --grpc-gateway_out=. ^
+        --grpc-gateway_opt=paths=source_relative ^
+        user.proto
\ No newline at end of file
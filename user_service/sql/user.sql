@@ -0,0 +1,20 @@
+CREATE TABLE `xx_user`(
+                        `id` BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT '主键',
+                        `create_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                        `create_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '创建者',
+                        `update_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+                        `update_by` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '更新者',
+                        `version` SMALLINT(5) UNSIGNED NOT NULL DEFAULT '0' COMMENT '乐观锁版本号',
+                        `is_del` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '是否删除：0正常1删除',
+
+                        `user_id` BIGINT(20) UNSIGNED NOT NULL COMMENT '用户id',
+                        `mobile` VARCHAR(11) NOT NULL COMMENT '手机号',
+                        `password` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '密码bcrypt哈希',
+                        `nickname` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '昵称',
+                        `roles` VARCHAR(128) NOT NULL DEFAULT 'buyer' COMMENT '角色，逗号分隔:buyer买家 merchant商家 room_operator直播间运营 admin管理员',
+                        `status` tinyint(4) UNSIGNED NOT NULL DEFAULT '0' COMMENT '状态:0正常 1禁用',
+
+                        UNIQUE (user_id),
+                        UNIQUE (mobile),
+                        INDEX (is_del)
+)ENGINE=INNODB DEFAULT CHARSET=utf8mb4 COMMENT = '用户表';